@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/internal/client"
+	"github.com/ctrl-vfr/astral-tui/internal/render"
+	"github.com/ctrl-vfr/astral-tui/pkg/astrocarto"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+var mapFlags struct {
+	chart     chartFlags
+	near      string
+	latitude  float64
+	longitude float64
+	radius    float64
+	bodies    []string
+	width     int
+	json      bool
+	output    string
+	svg       string
+	png       string
+}
+
+var mapCmd = &cobra.Command{
+	Use:   "map",
+	Short: "Astrocartography lines of a chart",
+	Long: `Computes the ASC, DSC, MC and IC lines of the natal planets: the places
+where each planet was rising, setting, culminating or anti-culminating at
+birth. Lists the lines passing within --radius km of a city (the birth
+place by default) and renders them on a world map as SVG or PNG.`,
+	RunE: runMap,
+}
+
+func init() {
+	mapFlags.chart.register(mapCmd)
+	mapCmd.Flags().StringVar(&mapFlags.near, "near", "", "city to list the nearby lines for (geocoded)")
+	mapCmd.Flags().Float64Var(&mapFlags.latitude, "near-lat", 0, "latitude of the place to list the nearby lines for")
+	mapCmd.Flags().Float64Var(&mapFlags.longitude, "near-lon", 0, "longitude of the place to list the nearby lines for")
+	mapCmd.Flags().Float64Var(&mapFlags.radius, "radius", 500, "distance in km within which lines are listed")
+	mapCmd.Flags().StringSliceVar(&mapFlags.bodies, "bodies", nil, "bodies to draw lines for (default: Sun to Pluto)")
+	mapCmd.Flags().IntVar(&mapFlags.width, "width", 1440, "width of the rendered map in pixels")
+	mapCmd.Flags().BoolVar(&mapFlags.json, "json", false, "write the lines and the nearby lines as JSON")
+	mapCmd.Flags().StringVarP(&mapFlags.output, "output", "o", "-", "file to write the listing to (- for stdout)")
+	mapCmd.Flags().StringVar(&mapFlags.svg, "svg", "", "render the map as SVG to this file")
+	mapCmd.Flags().StringVar(&mapFlags.png, "png", "", "render the map as PNG to this file")
+	rootCmd.AddCommand(mapCmd)
+}
+
+func runMap(cmd *cobra.Command, _ []string) error {
+	chart, err := mapFlags.chart.chart()
+	if err != nil {
+		return err
+	}
+
+	bodies := astrocarto.Bodies
+	if len(mapFlags.bodies) > 0 {
+		bodies = nil
+		for _, name := range mapFlags.bodies {
+			body, ok := position.BodyByName(name)
+			if !ok {
+				return fmt.Errorf("unknown body %q", name)
+			}
+			bodies = append(bodies, body)
+		}
+	}
+	lines := astrocarto.Lines(chart, bodies)
+
+	place := astrocarto.Point{Latitude: chart.Latitude, Longitude: chart.Longitude}
+	name := chart.Location
+	switch {
+	case mapFlags.near != "":
+		result, err := client.NewGeocodingClient().Search(mapFlags.near)
+		if err != nil {
+			return fmt.Errorf("geocode %q: %w", mapFlags.near, err)
+		}
+		place = astrocarto.Point{Latitude: result.Latitude, Longitude: result.Longitude}
+		name = mapFlags.near
+	case cmd.Flags().Changed("near-lat") || cmd.Flags().Changed("near-lon"):
+		place = astrocarto.Point{Latitude: mapFlags.latitude, Longitude: mapFlags.longitude}
+		name = ""
+	}
+	if name == "" {
+		name = formatPlace(place)
+	}
+
+	if mapFlags.svg != "" || mapFlags.png != "" {
+		generator := render.NewSVGMapGenerator(mapFlags.width).AddMarker(place.Latitude, place.Longitude, name)
+		svgData := generator.Generate(lines)
+		if mapFlags.svg != "" {
+			if err := os.WriteFile(mapFlags.svg, svgData, 0o644); err != nil {
+				return err
+			}
+		}
+		if mapFlags.png != "" {
+			width, height := generator.Size()
+			pngData, err := render.SVGToPNG(svgData, width, height)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(mapFlags.png, pngData, 0o644); err != nil {
+				return err
+			}
+		}
+		if mapFlags.output == "-" && !mapFlags.json {
+			return nil
+		}
+	}
+
+	out, err := openOutput(mapFlags.output)
+	if err != nil {
+		return err
+	}
+	if mapFlags.json {
+		err = astrocarto.NewExport(lines, place, mapFlags.radius).WriteJSON(out)
+	} else {
+		err = writeNearbyLines(out, name, mapFlags.radius, astrocarto.Near(lines, place, mapFlags.radius))
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func writeNearbyLines(w io.Writer, name string, radius float64, near []astrocarto.Proximity) error {
+	if len(near) == 0 {
+		_, err := fmt.Fprintf(w, "No line within %.0f km of %s\n", radius, name)
+		return err
+	}
+	fmt.Fprintf(w, "Lines within %.0f km of %s:\n", radius, name)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, p := range near {
+		fmt.Fprintf(tw, "  %s\t%s\t%.0f km\t%s\n", p.Line.Body, p.Line.Angle, p.Distance, formatPlace(p.Nearest))
+	}
+	return tw.Flush()
+}
+
+// formatPlace formats coordinates as "48.85°N 2.35°E"
+func formatPlace(p astrocarto.Point) string {
+	ns, ew := "N", "E"
+	lat, lon := p.Latitude, p.Longitude
+	if lat < 0 {
+		ns, lat = "S", -lat
+	}
+	if lon < 0 {
+		ew, lon = "W", -lon
+	}
+	return fmt.Sprintf("%.2f°%s %.2f°%s", lat, ns, lon, ew)
+}
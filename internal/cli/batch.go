@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+)
+
+var batchFlags struct {
+	input   string
+	output  string
+	workers int
+	noSky   bool
+}
+
+var batchCmd = &cobra.Command{
+	Use:   "batch",
+	Short: "Compute charts for every row of a CSV file",
+	Long: `Reads birth records from CSV (columns: id, name, date, time, timezone,
+latitude, longitude, location) and writes one chart per line as JSON Lines.
+Rows that fail are written with an "error" field instead of a chart.`,
+	RunE: runBatch,
+}
+
+func init() {
+	batchCmd.Flags().StringVarP(&batchFlags.input, "input", "i", "-", "CSV file to read (- for stdin)")
+	batchCmd.Flags().StringVarP(&batchFlags.output, "output", "o", "-", "JSON Lines file to write (- for stdout)")
+	batchCmd.Flags().IntVarP(&batchFlags.workers, "workers", "w", 0, "number of charts computed in parallel (default: number of CPUs)")
+	batchCmd.Flags().BoolVar(&batchFlags.noSky, "no-sky", false, "skip rise, transit and set times")
+	rootCmd.AddCommand(batchCmd)
+}
+
+func runBatch(cmd *cobra.Command, _ []string) error {
+	in, err := openInput(batchFlags.input)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	records, err := batch.ReadCSV(in)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	cfg := batch.DefaultConfig
+	cfg.Sky = !batchFlags.noSky
+	results := batch.Run(ctx, records, cfg, batchFlags.workers)
+
+	out, err := openOutput(batchFlags.output)
+	if err != nil {
+		return err
+	}
+	if err := batch.WriteJSONL(out, results); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, res := range results {
+		if res.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "%d of %d records failed\n", failed, len(results))
+	}
+	return context.Cause(ctx)
+}
+
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path)
+}
+
+func openOutput(path string) (io.WriteCloser, error) {
+	if path == "-" {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/bazi"
+)
+
+var baziFlags struct {
+	chart  chartFlags
+	sex    string
+	json   bool
+	output string
+}
+
+var baziCmd = &cobra.Command{
+	Use:   "bazi",
+	Short: "Four Pillars (BaZi) of a birth",
+	Long: `Computes the Chinese Four Pillars of a birth: the heavenly stem and
+earthly branch of its year, month, day and hour. The year and the month
+change at the solar terms, when the Sun reaches 15° of a sign; the day
+and the hour follow the local mean solar time of the birth place.
+
+With --sex the ten-year luck pillars are listed too: they run forward
+from the month pillar for yang years in men and yin years in women, and
+backward otherwise.`,
+	RunE: runBaZi,
+}
+
+func init() {
+	baziFlags.chart.register(baziCmd)
+	baziCmd.Flags().StringVar(&baziFlags.sex, "sex", "", "female or male, to list the luck pillars")
+	baziCmd.Flags().BoolVar(&baziFlags.json, "json", false, "write the pillars as JSON")
+	baziCmd.Flags().StringVarP(&baziFlags.output, "output", "o", "-", "file to write to (- for stdout)")
+	rootCmd.AddCommand(baziCmd)
+}
+
+func runBaZi(_ *cobra.Command, _ []string) error {
+	sex, err := bazi.ParseSex(baziFlags.sex)
+	if err != nil {
+		return err
+	}
+	rec, err := baziFlags.chart.record()
+	if err != nil {
+		return err
+	}
+	chart := bazi.Calculate(rec.DateTime, rec.Longitude)
+
+	out, err := openOutput(baziFlags.output)
+	if err != nil {
+		return err
+	}
+	if baziFlags.json {
+		err = bazi.NewExport(chart, sex).WriteJSON(out)
+	} else {
+		err = writePillars(out, chart, sex)
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func writePillars(w io.Writer, c bazi.Chart, sex bazi.Sex) error {
+	year := c.Year.Stem
+	fmt.Fprintf(w, "%s %s %s, day master %s %s %s (solar time %s)\n\n",
+		year.Polarity(), year.Element(), c.Animal(),
+		c.DayMaster().Hanzi(), c.DayMaster().Polarity(), c.DayMaster().Element(), c.SolarTime.Format("2006-01-02 15:04"))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Pillar\t干支\tStem\tBranch\tAnimal")
+	for i, p := range c.Pillars() {
+		fmt.Fprintf(tw, "%s\t%s\t%s (%s %s)\t%s (%s)\t%s\n", bazi.PillarNames[i], p.Hanzi(),
+			p.Stem, p.Stem.Polarity(), p.Stem.Element(), p.Branch, p.Branch.Element(), p.Branch.Animal())
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if sex == bazi.Unknown {
+		return nil
+	}
+
+	direction := "forward"
+	if !c.Forward(sex) {
+		direction = "backward"
+	}
+	fmt.Fprintf(w, "\nLuck pillars (%s, %s)\n", sex, direction)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	now := time.Now()
+	for _, l := range c.LuckPillars(sex, bazi.LuckPillarCount) {
+		mark := ""
+		if !now.Before(l.Start) && now.Before(l.End) {
+			mark = "← now"
+		}
+		fmt.Fprintf(tw, "%.1f\t%s\t%s\t%s–%s\t%s\n", l.Age, l.Hanzi(), l.Pillar,
+			l.Start.Format("2006-01"), l.End.Format("2006-01"), mark)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// chartFlags select the chart of a command: a stored profile, or a birth
+// given on the command line
+type chartFlags struct {
+	profile   string
+	store     string
+	date      string
+	zone      string
+	latitude  float64
+	longitude float64
+	location  string
+}
+
+// register adds the chart selection flags to a command
+func (f *chartFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.profile, "profile", "p", "", "name of a stored profile")
+	cmd.Flags().StringVar(&f.store, "store", "", "profile store file (default: user config directory)")
+	cmd.Flags().StringVar(&f.date, "date", "", `birth date and time, "YYYY-MM-DD HH:MM"`)
+	cmd.Flags().StringVar(&f.zone, "zone", "UTC", "time zone of --date (IANA name)")
+	cmd.Flags().Float64Var(&f.latitude, "lat", 0, "latitude of the birth place")
+	cmd.Flags().Float64Var(&f.longitude, "lon", 0, "longitude of the birth place")
+	cmd.Flags().StringVar(&f.location, "location", "", "name of the birth place")
+}
+
+// record returns the birth record selected by the flags
+func (f *chartFlags) record() (batch.Record, error) {
+	if f.profile != "" {
+		store, err := openStore(f.store)
+		if err != nil {
+			return batch.Record{}, err
+		}
+		p, ok := store.Find(f.profile)
+		if !ok {
+			return batch.Record{}, fmt.Errorf("no profile named %q", f.profile)
+		}
+		return p.Record(), nil
+	}
+
+	if f.date == "" {
+		return batch.Record{}, fmt.Errorf("give a profile with --profile or a birth with --date, --lat and --lon")
+	}
+	loc, err := time.LoadLocation(f.zone)
+	if err != nil {
+		return batch.Record{}, fmt.Errorf("invalid time zone %q", f.zone)
+	}
+	dateTime, err := time.ParseInLocation("2006-01-02 15:04", f.date, loc)
+	if err != nil {
+		return batch.Record{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD HH:MM", f.date)
+	}
+	return batch.Record{
+		DateTime:  dateTime,
+		Latitude:  f.latitude,
+		Longitude: f.longitude,
+		Location:  f.location,
+	}, nil
+}
+
+// chart computes the chart selected by the flags, without the local sky
+func (f *chartFlags) chart() (*horoscope.Chart, error) {
+	rec, err := f.record()
+	if err != nil {
+		return nil, err
+	}
+	cfg := batch.DefaultConfig
+	cfg.Sky = false
+	return batch.NewChart(rec, cfg)
+}
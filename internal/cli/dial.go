@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/internal/render"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// dialSize is the width and height of rendered dials in pixels
+const dialSize = 600
+
+var dialFlags struct {
+	chart    chartFlags
+	harmonic int
+	orb      float64
+	pointer  string
+	all      bool
+	json     bool
+	output   string
+	svg      string
+	png      string
+}
+
+var dialCmd = &cobra.Command{
+	Use:   "dial",
+	Short: "Midpoint trees and the 90° dial of a chart",
+	Long: `Lists the midpoint trees of a chart: for each planet and the North
+Node, the midpoints of other pairs that it occupies on the 90° dial. With
+--all every midpoint is listed in dial order. The dial itself can be
+rendered as SVG or PNG, optionally with a pointer on a body.`,
+	RunE: runDial,
+}
+
+func init() {
+	dialFlags.chart.register(dialCmd)
+	dialCmd.Flags().IntVar(&dialFlags.harmonic, "harmonic", 1, "use the nth harmonic chart")
+	dialCmd.Flags().Float64Var(&dialFlags.orb, "orb", horoscope.DefaultMidpointOrb, "midpoint orb in dial degrees")
+	dialCmd.Flags().StringVar(&dialFlags.pointer, "pointer", "", "body to point the rendered dial at")
+	dialCmd.Flags().BoolVar(&dialFlags.all, "all", false, "list every midpoint in dial order")
+	dialCmd.Flags().BoolVar(&dialFlags.json, "json", false, "write midpoints and trees as JSON")
+	dialCmd.Flags().StringVarP(&dialFlags.output, "output", "o", "-", "file to write the listing to (- for stdout)")
+	dialCmd.Flags().StringVar(&dialFlags.svg, "svg", "", "render the dial as SVG to this file")
+	dialCmd.Flags().StringVar(&dialFlags.png, "png", "", "render the dial as PNG to this file")
+	rootCmd.AddCommand(dialCmd)
+}
+
+func runDial(_ *cobra.Command, _ []string) error {
+	chart, err := dialFlags.chart.chart()
+	if err != nil {
+		return err
+	}
+	if dialFlags.harmonic < 1 {
+		return fmt.Errorf("harmonic must be at least 1")
+	}
+	if dialFlags.harmonic > 1 {
+		chart = chart.HarmonicChart(dialFlags.harmonic, horoscope.DefaultOrbs)
+	}
+
+	if dialFlags.svg != "" || dialFlags.png != "" {
+		if err := renderDial(chart); err != nil {
+			return err
+		}
+		if dialFlags.output == "-" && !dialFlags.json && !dialFlags.all {
+			return nil
+		}
+	}
+
+	out, err := openOutput(dialFlags.output)
+	if err != nil {
+		return err
+	}
+	switch {
+	case dialFlags.json:
+		err = chart.WriteMidpointsJSON(out, dialFlags.orb)
+	case dialFlags.all:
+		err = writeMidpoints(out, chart.Midpoints())
+	default:
+		err = writeMidpointTrees(out, chart.MidpointTrees(dialFlags.orb))
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// renderDial writes the dial of the chart's midpoint bodies to the
+// requested SVG and PNG files
+func renderDial(chart *horoscope.Chart) error {
+	var positions []position.Position
+	for _, body := range horoscope.MidpointBodies {
+		if pos := chart.GetPosition(body); pos != nil {
+			positions = append(positions, *pos)
+		}
+	}
+
+	generator := render.NewSVGDialGenerator(dialSize)
+	if dialFlags.pointer != "" {
+		body, ok := position.BodyByName(dialFlags.pointer)
+		if !ok {
+			return fmt.Errorf("unknown body %q", dialFlags.pointer)
+		}
+		pos := chart.GetPosition(body)
+		if pos == nil {
+			return fmt.Errorf("%s is not in the chart", body)
+		}
+		generator.SetPointer(pos.EclipticLongitude, dialFlags.orb)
+	}
+	svgData := generator.Generate(positions)
+
+	if dialFlags.svg != "" {
+		if err := os.WriteFile(dialFlags.svg, svgData, 0o644); err != nil {
+			return err
+		}
+	}
+	if dialFlags.png != "" {
+		pngData, err := render.SVGToPNG(svgData, dialSize, dialSize)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(dialFlags.png, pngData, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMidpointTrees(w io.Writer, trees []horoscope.MidpointTree) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, t := range trees {
+		fmt.Fprintf(tw, "%s\t%s\t\n", t.Focus, formatDial(horoscope.DialPosition(t.Longitude)))
+		for _, c := range t.Contacts {
+			fmt.Fprintf(tw, "  = %s/%s\t%s\torb %s\n", c.Body1, c.Body2, formatDial(c.Dial()), formatDial(c.Orb))
+		}
+	}
+	return tw.Flush()
+}
+
+func writeMidpoints(w io.Writer, midpoints []horoscope.Midpoint) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, m := range midpoints {
+		fmt.Fprintf(tw, "%s\t%s/%s\t%s\n", formatDial(m.Dial()), m.Body1, m.Body2, horoscope.LongitudeToZodiac(m.Longitude))
+	}
+	return tw.Flush()
+}
+
+// formatDial formats degrees as degrees and minutes
+func formatDial(deg float64) string {
+	d := math.Floor(deg)
+	m := math.Round((deg - d) * 60)
+	if m == 60 {
+		d, m = d+1, 0
+	}
+	return fmt.Sprintf("%02.0f°%02.0f'", d, m)
+}
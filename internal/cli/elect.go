@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/election"
+)
+
+var electFlags struct {
+	chart  chartFlags
+	from   string
+	days   int
+	step   time.Duration
+	limit  int
+	json   bool
+	output string
+}
+
+var electCmd = &cobra.Command{
+	Use:   "elect CRITERIA",
+	Short: "Search for moments matching electional criteria",
+	Long: `Scans the ephemeris for the windows in which every criterion holds and
+lists the best ones, ranked by how closely the criteria are met at their
+peak. Criteria are separated by commas or "and", for example:
+
+  astral elect "moon waxing, moon not void, moon in earth, mercury direct,
+                venus trine natal sun" -p alice
+
+Each criterion names a body followed by waxing, waning or void (Moon
+only), direct, retrograde or stationary, in <sign|element|modality>, or
+<aspect> [natal] <body> [orb N]. Either may be preceded by "not". Natal
+criteria need the chart of a profile or of --date, --lat and --lon.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runElect,
+}
+
+func init() {
+	electFlags.chart.register(electCmd)
+	electCmd.Flags().StringVar(&electFlags.from, "from", "", `start of the search, "YYYY-MM-DD" (default: today)`)
+	electCmd.Flags().IntVar(&electFlags.days, "days", 90, "number of days searched")
+	electCmd.Flags().DurationVar(&electFlags.step, "step", time.Hour, "interval between the tested moments")
+	electCmd.Flags().IntVarP(&electFlags.limit, "limit", "n", 10, "number of windows listed (0 for all)")
+	electCmd.Flags().BoolVar(&electFlags.json, "json", false, "write the windows as JSON")
+	electCmd.Flags().StringVarP(&electFlags.output, "output", "o", "-", "file to write to (- for stdout)")
+	rootCmd.AddCommand(electCmd)
+}
+
+func runElect(_ *cobra.Command, args []string) error {
+	criteria, err := election.Parse(args[0])
+	if err != nil {
+		return err
+	}
+
+	loc, err := time.LoadLocation(electFlags.chart.zone)
+	if err != nil {
+		return fmt.Errorf("invalid time zone %q", electFlags.chart.zone)
+	}
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if electFlags.from != "" {
+		if from, err = time.ParseInLocation("2006-01-02", electFlags.from, loc); err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", electFlags.from)
+		}
+	}
+	if electFlags.days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+	to := from.AddDate(0, 0, electFlags.days)
+
+	cfg := election.DefaultConfig
+	cfg.Step = electFlags.step
+	cfg.Limit = electFlags.limit
+	if election.NeedsNatal(criteria) {
+		if cfg.Natal, err = electFlags.chart.chart(); err != nil {
+			return err
+		}
+	}
+
+	windows, err := election.Search(criteria, from, to, cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := openOutput(electFlags.output)
+	if err != nil {
+		return err
+	}
+	if electFlags.json {
+		err = election.NewExport(criteria, from, to, cfg.Step, windows).WriteJSON(out)
+	} else {
+		err = writeWindows(out, criteria, windows, loc)
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func writeWindows(w io.Writer, criteria []election.Criterion, windows []election.Window, loc *time.Location) error {
+	fmt.Fprintf(w, "Criteria: %s\n", election.Describe(criteria))
+	if len(windows) == 0 {
+		_, err := fmt.Fprintln(w, "No window matches every criterion")
+		return err
+	}
+	const layout = "2006-01-02 15:04"
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Start\tEnd\tPeak\tScore")
+	for _, win := range windows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			win.Start.In(loc).Format(layout), win.End.In(loc).Format(layout), win.Peak.In(loc).Format(layout), scoreBar(win.Score))
+	}
+	return tw.Flush()
+}
+
+// scoreBar shows a score from 0 to 1 as a bar of five cells
+func scoreBar(score float64) string {
+	full := int(score*5 + 0.5)
+	return strings.Repeat("●", full) + strings.Repeat("○", 5-full) + fmt.Sprintf(" %.2f", score)
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/internal/render"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// wheelSize is the width and height of rendered wheels in pixels
+const wheelSize = 600
+
+var harmonicFlags struct {
+	chart  chartFlags
+	json   bool
+	output string
+	svg    string
+	png    string
+}
+
+var harmonicCmd = &cobra.Command{
+	Use:   "harmonic N",
+	Short: "Compute the nth harmonic chart",
+	Long: `Multiplies every longitude of a chart by N and recomputes the aspects
+between the harmonic positions. The harmonic chart is listed, exported as
+JSON like the charts of the interface, or rendered as a wheel.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHarmonic,
+}
+
+func init() {
+	harmonicFlags.chart.register(harmonicCmd)
+	harmonicCmd.Flags().BoolVar(&harmonicFlags.json, "json", false, "write the harmonic chart as JSON")
+	harmonicCmd.Flags().StringVarP(&harmonicFlags.output, "output", "o", "-", "file to write to (- for stdout)")
+	harmonicCmd.Flags().StringVar(&harmonicFlags.svg, "svg", "", "render the wheel as SVG to this file")
+	harmonicCmd.Flags().StringVar(&harmonicFlags.png, "png", "", "render the wheel as PNG to this file")
+	rootCmd.AddCommand(harmonicCmd)
+}
+
+func runHarmonic(_ *cobra.Command, args []string) error {
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 {
+		return fmt.Errorf("invalid harmonic %q", args[0])
+	}
+	radix, err := harmonicFlags.chart.chart()
+	if err != nil {
+		return err
+	}
+	chart := radix.HarmonicChart(n, horoscope.DefaultOrbs)
+
+	if harmonicFlags.svg != "" || harmonicFlags.png != "" {
+		svgData := render.NewSVGWheelGenerator(wheelSize).Generate(chart.Positions)
+		if harmonicFlags.svg != "" {
+			if err := os.WriteFile(harmonicFlags.svg, svgData, 0o644); err != nil {
+				return err
+			}
+		}
+		if harmonicFlags.png != "" {
+			pngData, err := render.SVGToPNG(svgData, wheelSize, wheelSize)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(harmonicFlags.png, pngData, 0o644); err != nil {
+				return err
+			}
+		}
+		if harmonicFlags.output == "-" && !harmonicFlags.json {
+			return nil
+		}
+	}
+
+	out, err := openOutput(harmonicFlags.output)
+	if err != nil {
+		return err
+	}
+	if harmonicFlags.json {
+		err = chart.WriteJSON(out)
+	} else {
+		err = writeChart(out, chart)
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// writeChart lists the positions and aspects of a chart
+func writeChart(w io.Writer, chart *horoscope.Chart) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, pos := range chart.Positions {
+		fmt.Fprintf(tw, "%s\t%s\n", pos.Body, horoscope.LongitudeToZodiac(pos.EclipticLongitude))
+	}
+	fmt.Fprintln(tw)
+	for _, a := range chart.Aspects {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f°\n", a.Body1, a.Type, a.Body2, a.Orb)
+	}
+	return tw.Flush()
+}
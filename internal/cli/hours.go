@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/internal/client"
+	"github.com/ctrl-vfr/astral-tui/pkg/astrocarto"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+var hoursFlags struct {
+	city      string
+	latitude  float64
+	longitude float64
+	date      string
+	zone      string
+	json      bool
+	output    string
+}
+
+var hoursCmd = &cobra.Command{
+	Use:   "hours",
+	Short: "Planetary hours of a day at a place",
+	Long: `Lists the planetary hours of a day: the twelve unequal hours from sunrise
+to sunset and the twelve from sunset to the next sunrise, ruled in turn
+by the planets in Chaldean order from the ruler of the weekday. The
+place is a geocoded --city, --lat and --lon, or $ASTRAL_CITY; without
+--date the day running now is listed and its current hour marked.`,
+	RunE: runHours,
+}
+
+func init() {
+	hoursCmd.Flags().StringVar(&hoursFlags.city, "city", "", "city to compute the hours for (geocoded, default: $ASTRAL_CITY)")
+	hoursCmd.Flags().Float64Var(&hoursFlags.latitude, "lat", 0, "latitude of the place")
+	hoursCmd.Flags().Float64Var(&hoursFlags.longitude, "lon", 0, "longitude of the place")
+	hoursCmd.Flags().StringVar(&hoursFlags.date, "date", "", `day, "YYYY-MM-DD" (default: the planetary day running now)`)
+	hoursCmd.Flags().StringVar(&hoursFlags.zone, "zone", "Local", "time zone the hours are shown in (IANA name)")
+	hoursCmd.Flags().BoolVar(&hoursFlags.json, "json", false, "write the day and its hours as JSON")
+	hoursCmd.Flags().StringVarP(&hoursFlags.output, "output", "o", "-", "file to write to (- for stdout)")
+	rootCmd.AddCommand(hoursCmd)
+}
+
+func runHours(cmd *cobra.Command, _ []string) error {
+	loc, err := time.LoadLocation(hoursFlags.zone)
+	if err != nil {
+		return fmt.Errorf("invalid time zone %q", hoursFlags.zone)
+	}
+
+	var lat, lon float64
+	var name string
+	switch city := hoursFlags.city; {
+	case cmd.Flags().Changed("lat") || cmd.Flags().Changed("lon"):
+		lat, lon = hoursFlags.latitude, hoursFlags.longitude
+		name = formatPlace(astrocarto.Point{Latitude: lat, Longitude: lon})
+	default:
+		if city == "" {
+			city = os.Getenv("ASTRAL_CITY")
+		}
+		if city == "" {
+			return fmt.Errorf("give a place with --city, --lat and --lon, or set ASTRAL_CITY")
+		}
+		result, err := client.NewGeocodingClient().Search(city)
+		if err != nil {
+			return fmt.Errorf("geocode %q: %w", city, err)
+		}
+		lat, lon, name = result.Latitude, result.Longitude, city
+	}
+
+	now := time.Now().In(loc)
+	var day horoscope.PlanetaryDay
+	if hoursFlags.date != "" {
+		date, err := time.ParseInLocation("2006-01-02", hoursFlags.date, loc)
+		if err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", hoursFlags.date)
+		}
+		day, err = horoscope.PlanetaryDayFor(date, lat, lon)
+		if err != nil {
+			return err
+		}
+	} else if day, err = horoscope.PlanetaryDayAt(now, lat, lon); err != nil {
+		return err
+	}
+
+	out, err := openOutput(hoursFlags.output)
+	if err != nil {
+		return err
+	}
+	if hoursFlags.json {
+		err = day.WriteJSON(out)
+	} else {
+		err = writeHours(out, name, day, now, loc, lon)
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func writeHours(w io.Writer, name string, day horoscope.PlanetaryDay, now time.Time, loc *time.Location, longitude float64) error {
+	const layout = "15:04"
+	// The date at the place, whose weekday gives the ruler
+	date := horoscope.LocalMeanTime(day.Sunrise, longitude).Format("Monday 2006-01-02")
+	fmt.Fprintf(w, "%s, %s: day of %s %s\n", name, date, day.Ruler.Symbol(), day.Ruler)
+	fmt.Fprintf(w, "Sunrise %s, sunset %s, next sunrise %s\n\n",
+		day.Sunrise.In(loc).Format(layout), day.Sunset.In(loc).Format(layout), day.NextSunrise.In(loc).Format(layout))
+
+	current, _ := day.HourAt(now)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, h := range day.Hours {
+		period := "day"
+		if h.Night {
+			period = "night"
+		}
+		mark := ""
+		if h == current {
+			mark = fmt.Sprintf("← now, %s left", formatRemaining(h.Remaining(now)))
+		}
+		fmt.Fprintf(tw, "%s %2d\t%s–%s\t%s %s\t%s\n",
+			period, h.Number, h.Start.In(loc).Format(layout), h.End.In(loc).Format(layout), h.Ruler.Symbol(), h.Ruler, mark)
+	}
+	return tw.Flush()
+}
+
+// formatRemaining shows a duration in hours and minutes, as "1h05" or "42m"
+func formatRemaining(d time.Duration) string {
+	m := int(d.Round(time.Minute).Minutes())
+	if m < 60 {
+		return fmt.Sprintf("%dm", m)
+	}
+	return fmt.Sprintf("%dh%02d", m/60, m%60)
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/internal/client"
+	"github.com/ctrl-vfr/astral-tui/pkg/profile"
+)
+
+var importFlags struct {
+	format    string
+	mapping   []string
+	dateOrder string
+	zone      string
+	noGeocode bool
+	dryRun    bool
+	store     string
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import FILE...",
+	Short: "Import birth records from AAF or CSV into the profile store",
+	Long: `Reads Astrodienst AAF files (#A93/#B93 records) or CSV files with name,
+date, time, zone, place and lat/lon columns. Records without coordinates are
+geocoded from their place. Every record that fails to parse or geocode is
+listed; with --dry-run nothing is saved.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runImport,
+}
+
+func init() {
+	importCmd.Flags().StringVar(&importFlags.format, "format", "auto", "input format: auto, aaf or csv")
+	importCmd.Flags().StringSliceVar(&importFlags.mapping, "map", nil, "CSV column for a field, e.g. name=Full Name,date=DOB (fields: name, date, time, zone, place, lat, lon)")
+	importCmd.Flags().StringVar(&importFlags.dateOrder, "date-order", string(profile.DateAuto), "CSV slash dates: dmy, mdy, or auto to fail dates that read both ways")
+	importCmd.Flags().StringVar(&importFlags.zone, "zone", "", "time zone for records without one (IANA name, e.g. Europe/Paris)")
+	importCmd.Flags().BoolVar(&importFlags.noGeocode, "no-geocode", false, "fail records without coordinates instead of geocoding them")
+	importCmd.Flags().BoolVarP(&importFlags.dryRun, "dry-run", "n", false, "report what would be imported without saving")
+	importCmd.Flags().StringVar(&importFlags.store, "store", "", "profile store file (default: user config directory)")
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	mapping, err := parseMapping(importFlags.mapping)
+	if err != nil {
+		return err
+	}
+	order := profile.DateOrder(importFlags.dateOrder)
+	switch order {
+	case profile.DateAuto, profile.DayMonth, profile.MonthDay:
+	default:
+		return fmt.Errorf("invalid date order %q", importFlags.dateOrder)
+	}
+
+	opts := profile.ResolveOptions{}
+	if importFlags.zone != "" {
+		if opts.DefaultZone, err = time.LoadLocation(importFlags.zone); err != nil {
+			return fmt.Errorf("invalid zone %q", importFlags.zone)
+		}
+	}
+	if !importFlags.noGeocode {
+		opts.Geocoder = &nominatimGeocoder{client: client.NewGeocodingClient()}
+	}
+
+	store, err := openStore(importFlags.store)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	for _, path := range args {
+		entries, err := readEntries(path, mapping, order)
+		if err != nil {
+			return err
+		}
+		report := profile.Resolve(path, entries, opts)
+		report.Apply(store)
+		if err := report.WriteText(out); err != nil {
+			return err
+		}
+	}
+
+	if importFlags.dryRun {
+		fmt.Fprintln(out, "Dry run: the profile store was not changed")
+		return nil
+	}
+	return store.Save()
+}
+
+// readEntries reads a file in the format given by --format or its extension
+func readEntries(path string, mapping map[string][]string, order profile.DateOrder) ([]profile.Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	format := importFlags.format
+	if format == "auto" {
+		format = "csv"
+		if strings.EqualFold(filepath.Ext(path), ".aaf") {
+			format = "aaf"
+		}
+	}
+	switch format {
+	case "aaf":
+		return profile.ReadAAF(f, path)
+	case "csv":
+		return profile.ReadCSV(f, path, mapping, order)
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// parseMapping reads field=column pairs; a field may be given several times
+func parseMapping(pairs []string) (map[string][]string, error) {
+	mapping := make(map[string][]string)
+	for _, pair := range pairs {
+		field, column, ok := strings.Cut(pair, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if _, known := profile.DefaultMapping[field]; !ok || !known {
+			return nil, fmt.Errorf("invalid mapping %q", pair)
+		}
+		mapping[field] = append(mapping[field], strings.TrimSpace(column))
+	}
+	return mapping, nil
+}
+
+func openStore(path string) (*profile.Store, error) {
+	if path == "" {
+		var err error
+		if path, err = profile.DefaultStorePath(); err != nil {
+			return nil, err
+		}
+	}
+	return profile.OpenStore(path)
+}
+
+// nominatimGeocoder adapts the geocoding client, keeping to Nominatim's
+// limit of one request per second
+type nominatimGeocoder struct {
+	client *client.GeocodingClient
+	last   time.Time
+}
+
+func (g *nominatimGeocoder) Geocode(place string) (float64, float64, string, error) {
+	if wait := time.Second - time.Since(g.last); wait > 0 {
+		time.Sleep(wait)
+	}
+	g.last = time.Now()
+
+	res, err := g.client.Search(place)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return res.Latitude, res.Longitude, res.DisplayName, nil
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+)
+
+var profilesFlags struct {
+	store string
+	csv   bool
+}
+
+var profilesCmd = &cobra.Command{
+	Use:   "profiles",
+	Short: "List the profiles in the profile store",
+	Long: `Lists the stored birth records. With --csv they are written in the
+format read by the batch and stats commands.`,
+	RunE: runProfiles,
+}
+
+func init() {
+	profilesCmd.Flags().StringVar(&profilesFlags.store, "store", "", "profile store file (default: user config directory)")
+	profilesCmd.Flags().BoolVar(&profilesFlags.csv, "csv", false, "write CSV for astral batch and astral stats")
+	rootCmd.AddCommand(profilesCmd)
+}
+
+func runProfiles(cmd *cobra.Command, _ []string) error {
+	store, err := openStore(profilesFlags.store)
+	if err != nil {
+		return err
+	}
+
+	if profilesFlags.csv {
+		w := csv.NewWriter(cmd.OutOrStdout())
+		_ = w.Write([]string{batch.ColumnID, batch.ColumnName, batch.ColumnDate, batch.ColumnTime,
+			batch.ColumnTimezone, batch.ColumnLatitude, batch.ColumnLongitude, batch.ColumnLocation})
+		for i, p := range store.Profiles {
+			// Times are written in UTC so that fixed-offset zones survive
+			utc := p.DateTime.UTC()
+			_ = w.Write([]string{strconv.Itoa(i + 1), p.Name, utc.Format(time.DateOnly), utc.Format("15:04:05"), "UTC",
+				strconv.FormatFloat(p.Latitude, 'f', -1, 64), strconv.FormatFloat(p.Longitude, 'f', -1, 64), p.Location})
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	for _, p := range store.Profiles {
+		fmt.Fprintf(tw, "%s\t%s\t%.4f\t%.4f\t%s\n", p.Name, p.DateTime.Format("2006-01-02 15:04 MST"), p.Latitude, p.Longitude, p.Location)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/rectify"
+)
+
+var rectifyFlags struct {
+	profile   string
+	store     string
+	date      string
+	zone      string
+	latitude  float64
+	longitude float64
+	between   string
+	events    string
+	event     []string
+	step      time.Duration
+	limit     int
+	json      bool
+	output    string
+}
+
+var rectifyCmd = &cobra.Command{
+	Use:   "rectify",
+	Short: "Estimate an unknown birth time from life events",
+	Long: `Tests every birth time of a window against dated life events and ranks
+the times whose angles and house rulers are hit on those dates: slow
+transits to the angles and to the rulers of the houses of the event,
+solar arc directions between the angles and the natal planets, and the
+progressed Moon on an angle. Each candidate is listed with its hits.
+
+Events are given as "YYYY-MM-DD[,kind[,label]]" with --event, or as CSV
+lines in the same format with --events. Kinds: ` + strings.Join(kindNames(), ", ") + `.`,
+	RunE: runRectify,
+}
+
+func init() {
+	rectifyCmd.Flags().StringVarP(&rectifyFlags.profile, "profile", "p", "", "stored profile giving the birth date and place")
+	rectifyCmd.Flags().StringVar(&rectifyFlags.store, "store", "", "profile store file (default: user config directory)")
+	rectifyCmd.Flags().StringVar(&rectifyFlags.date, "date", "", `birth date, "YYYY-MM-DD"`)
+	rectifyCmd.Flags().StringVar(&rectifyFlags.zone, "zone", "UTC", "time zone of the birth (IANA name)")
+	rectifyCmd.Flags().Float64Var(&rectifyFlags.latitude, "lat", 0, "latitude of the birth place")
+	rectifyCmd.Flags().Float64Var(&rectifyFlags.longitude, "lon", 0, "longitude of the birth place")
+	rectifyCmd.Flags().StringVar(&rectifyFlags.between, "between", "00:00-23:59", `window of possible birth times, "HH:MM-HH:MM"`)
+	rectifyCmd.Flags().StringVar(&rectifyFlags.events, "events", "", "CSV file of events (date,kind,label)")
+	rectifyCmd.Flags().StringArrayVarP(&rectifyFlags.event, "event", "e", nil, `event "YYYY-MM-DD[,kind[,label]]" (repeatable)`)
+	rectifyCmd.Flags().DurationVar(&rectifyFlags.step, "step", rectify.DefaultConfig.Step, "interval between the tested times")
+	rectifyCmd.Flags().IntVarP(&rectifyFlags.limit, "limit", "n", rectify.DefaultConfig.Limit, "number of candidate times listed")
+	rectifyCmd.Flags().BoolVar(&rectifyFlags.json, "json", false, "write the candidates as JSON")
+	rectifyCmd.Flags().StringVarP(&rectifyFlags.output, "output", "o", "-", "file to write to (- for stdout)")
+	rootCmd.AddCommand(rectifyCmd)
+}
+
+func kindNames() []string {
+	var names []string
+	for _, k := range rectify.Kinds() {
+		names = append(names, string(k))
+	}
+	return names
+}
+
+func runRectify(_ *cobra.Command, _ []string) error {
+	day, lat, lon, err := rectifyBirth()
+	if err != nil {
+		return err
+	}
+	from, to, err := rectifyWindow(day)
+	if err != nil {
+		return err
+	}
+	events, err := rectifyEvents()
+	if err != nil {
+		return err
+	}
+
+	cfg := rectify.DefaultConfig
+	cfg.Step = rectifyFlags.step
+	cfg.Limit = rectifyFlags.limit
+	candidates, err := rectify.Rectify(from, to, lat, lon, events, cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := openOutput(rectifyFlags.output)
+	if err != nil {
+		return err
+	}
+	if rectifyFlags.json {
+		err = rectify.NewExport(from, to, lat, lon, events, candidates).WriteJSON(out)
+	} else {
+		err = writeCandidates(out, candidates, day.Location())
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// rectifyBirth returns midnight of the birth day, in the birth time zone,
+// and the birth place
+func rectifyBirth() (time.Time, float64, float64, error) {
+	if rectifyFlags.profile != "" {
+		store, err := openStore(rectifyFlags.store)
+		if err != nil {
+			return time.Time{}, 0, 0, err
+		}
+		p, ok := store.Find(rectifyFlags.profile)
+		if !ok {
+			return time.Time{}, 0, 0, fmt.Errorf("no profile named %q", rectifyFlags.profile)
+		}
+		dt := p.DateTime
+		if loc, err := time.LoadLocation(p.Timezone); err == nil && p.Timezone != "" {
+			dt = dt.In(loc)
+		}
+		day := time.Date(dt.Year(), dt.Month(), dt.Day(), 0, 0, 0, 0, dt.Location())
+		return day, p.Latitude, p.Longitude, nil
+	}
+
+	if rectifyFlags.date == "" {
+		return time.Time{}, 0, 0, fmt.Errorf("give a profile with --profile or a birth date with --date, --lat and --lon")
+	}
+	loc, err := time.LoadLocation(rectifyFlags.zone)
+	if err != nil {
+		return time.Time{}, 0, 0, fmt.Errorf("invalid time zone %q", rectifyFlags.zone)
+	}
+	day, err := time.ParseInLocation("2006-01-02", rectifyFlags.date, loc)
+	if err != nil {
+		return time.Time{}, 0, 0, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", rectifyFlags.date)
+	}
+	return day, rectifyFlags.latitude, rectifyFlags.longitude, nil
+}
+
+// rectifyWindow returns the window of birth times on the birth day
+func rectifyWindow(day time.Time) (time.Time, time.Time, error) {
+	start, end, ok := strings.Cut(rectifyFlags.between, "-")
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid window %q, expected HH:MM-HH:MM", rectifyFlags.between)
+	}
+	at := func(s string) (time.Time, error) {
+		t, err := time.Parse("15:04", strings.TrimSpace(s))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q, expected HH:MM", s)
+		}
+		return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, day.Location()), nil
+	}
+	from, err := at(start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := at(end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return from, to, nil
+}
+
+func rectifyEvents() ([]rectify.Event, error) {
+	var events []rectify.Event
+	if rectifyFlags.events != "" {
+		in, err := openInput(rectifyFlags.events)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = in.Close() }()
+		if events, err = rectify.ReadEvents(in); err != nil {
+			return nil, fmt.Errorf("%s: %w", rectifyFlags.events, err)
+		}
+	}
+	for _, s := range rectifyFlags.event {
+		e, err := rectify.ParseEvent(s)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("give events with --event or --events")
+	}
+	return events, nil
+}
+
+func writeCandidates(w io.Writer, candidates []rectify.Candidate, loc *time.Location) error {
+	if len(candidates) == 0 {
+		_, err := fmt.Fprintln(w, "No candidate time")
+		return err
+	}
+	for i, c := range candidates {
+		fmt.Fprintf(w, "%d. %s  ASC %s  MC %s  score %.2f\n", i+1, c.Time.In(loc).Format("15:04 MST"),
+			horoscope.LongitudeToZodiac(c.Ascendant).ShortString(), horoscope.LongitudeToZodiac(c.Midheaven).ShortString(), c.Score)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, h := range c.Hits {
+			fmt.Fprintf(tw, "   %s\t%s\t%.2f°\t%.2f\n", h.Event, h.Description, h.Orb, h.Score)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -2,16 +2,31 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/spf13/cobra"
 
+	"github.com/ctrl-vfr/astral-tui/internal/preflight"
 	"github.com/ctrl-vfr/astral-tui/internal/tui"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "astral",
 	Short: "Interactive astrological chart TUI",
 	Long:  `Interactive terminal application for calculating and visualizing natal charts.`,
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return loadElementFiles()
+	},
 	RunE: func(_ *cobra.Command, _ []string) error {
+		// The checks only concern the interactive interface
+		results := preflight.RunChecks()
+		if !preflight.PrintResults(results) {
+			fmt.Println()
+			os.Exit(1)
+		}
 		return tui.Run()
 	},
 }
@@ -20,3 +35,17 @@ var rootCmd = &cobra.Command{
 func Execute() error {
 	return rootCmd.Execute()
 }
+
+// loadElementFiles registers the extra bodies listed in ASTRAL_ELEMENTS,
+// a list of MPCORB or JSON element files separated like PATH
+func loadElementFiles() error {
+	for _, path := range filepath.SplitList(os.Getenv("ASTRAL_ELEMENTS")) {
+		if path == "" {
+			continue
+		}
+		if _, err := position.LoadElementsFile(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
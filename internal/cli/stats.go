@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"os/signal"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/stats"
+)
+
+var statsFlags struct {
+	input   string
+	output  string
+	control int
+	seed    uint64
+	workers int
+	factors []string
+	json    bool
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Frequency statistics over the charts of a CSV file",
+	Long: `Reads birth records from CSV (same columns as batch) and reports sign,
+house, element, modality, Gauquelin sector and aspect frequencies for the
+Sun to Pluto. Each distribution is compared by a chi-square test with a
+control group built by shuffling the dates, times and places of the sample.`,
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().StringVarP(&statsFlags.input, "input", "i", "-", "CSV file to read (- for stdin)")
+	statsCmd.Flags().StringVarP(&statsFlags.output, "output", "o", "-", "report file to write (- for stdout)")
+	statsCmd.Flags().IntVarP(&statsFlags.control, "control", "c", 10, "control group size as a multiple of the sample (0: uniform expectation)")
+	statsCmd.Flags().Uint64Var(&statsFlags.seed, "seed", 1, "random seed for the control group")
+	statsCmd.Flags().IntVarP(&statsFlags.workers, "workers", "w", 0, "number of charts computed in parallel (default: number of CPUs)")
+	statsCmd.Flags().StringSliceVarP(&statsFlags.factors, "factor", "f", nil, "only report these factors (sign, house, element, modality, sector, aspect)")
+	statsCmd.Flags().BoolVar(&statsFlags.json, "json", false, "write the report as JSON")
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, _ []string) error {
+	in, err := openInput(statsFlags.input)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	records, err := batch.ReadCSV(in)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	cfg := batch.DefaultConfig
+	cfg.Sky = false
+
+	sample, failed := charts(batch.Run(ctx, records, cfg, statsFlags.workers))
+	if failed > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "%d of %d records skipped\n", failed, len(records))
+	}
+	if len(sample) == 0 {
+		return fmt.Errorf("no valid records in %s", statsFlags.input)
+	}
+
+	rng := rand.New(rand.NewPCG(statsFlags.seed, statsFlags.seed))
+	controlRecords := stats.Shuffle(records, statsFlags.control*len(sample), rng)
+	control, _ := charts(batch.Run(ctx, controlRecords, cfg, statsFlags.workers))
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	report := stats.Analyze(sample, control)
+	if len(statsFlags.factors) > 0 {
+		report.Distributions = slices.DeleteFunc(report.Distributions, func(d stats.Distribution) bool {
+			return !slices.Contains(statsFlags.factors, string(d.Factor))
+		})
+	}
+
+	out, err := openOutput(statsFlags.output)
+	if err != nil {
+		return err
+	}
+	if statsFlags.json {
+		err = report.WriteJSON(out)
+	} else {
+		err = report.WriteTable(out)
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// charts keeps the charts of successful results and counts the failures
+func charts(results []batch.Result) ([]*horoscope.Chart, int) {
+	var result []*horoscope.Chart
+	failed := 0
+	for _, res := range results {
+		if res.Err != nil {
+			failed++
+			continue
+		}
+		result = append(result, res.Chart)
+	}
+	return result, failed
+}
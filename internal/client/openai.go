@@ -144,49 +144,259 @@ func buildUserPrompt(chart *horoscope.Chart, userQuestion string) string {
 			continue
 		}
 		zodiac := horoscope.LongitudeToZodiac(pos.EclipticLongitude)
-		sb.WriteString(fmt.Sprintf("- %s %s: %s %d°%d'%s\n",
-			pos.Body.Symbol(), pos.Body.String(), zodiac.Sign.String(), zodiac.Degrees, zodiac.Minutes, retrogradeLabel(pos.Retrograde)))
+		sb.WriteString(fmt.Sprintf("- %s %s: %s %d°%d'%s, %s %+.1f°%s\n",
+			pos.Body.Symbol(), pos.Body.String(), zodiac.Sign.String(), zodiac.Degrees, zodiac.Minutes, retrogradeLabel(pos.Retrograde),
+			i18n.T("PromptDeclination"), pos.Declination, outOfBoundsLabel(pos)+stationaryLabel(pos)))
 	}
 
 	// Birth chart data
 	sb.WriteString(fmt.Sprintf("\n## %s:\n", i18n.T("PromptNatalTitle")))
 	sb.WriteString(fmt.Sprintf("%s: %s\n", i18n.T("PromptBirthDate"), chart.DateTime.Format("02/01/2006 15:04")))
-	sb.WriteString(fmt.Sprintf("%s: %s (%.4f, %.4f)\n\n", i18n.T("PromptLocation"), chart.Location, chart.Latitude, chart.Longitude))
+	sb.WriteString(fmt.Sprintf("%s: %s (%.4f, %.4f)\n", i18n.T("PromptLocation"), chart.Location, chart.Latitude, chart.Longitude))
+	if r := chart.Relocation; r != nil {
+		sb.WriteString(fmt.Sprintf("%s: %s (%.4f, %.4f)\n", i18n.T("PromptBirthPlace"), r.Location, r.Latitude, r.Longitude))
+		sb.WriteString(i18n.T("PromptRelocated") + "\n")
+	}
+	sb.WriteString("\n")
 
 	sb.WriteString(fmt.Sprintf("%s:\n", i18n.T("PromptPlanetPositions")))
 	for _, pos := range chart.Positions {
 		zodiac := horoscope.LongitudeToZodiac(pos.EclipticLongitude)
-		sb.WriteString(fmt.Sprintf("- %s %s: %s %d°%d'%s\n",
-			pos.Body.Symbol(), pos.Body.String(), zodiac.Sign.String(), zodiac.Degrees, zodiac.Minutes, retrogradeLabel(pos.Retrograde)))
+		sb.WriteString(fmt.Sprintf("- %s %s: %s %d°%d'%s, %s %+.1f°%s\n",
+			pos.Body.Symbol(), pos.Body.String(), zodiac.Sign.String(), zodiac.Degrees, zodiac.Minutes, retrogradeLabel(pos.Retrograde),
+			i18n.T("PromptDeclination"), pos.Declination, outOfBoundsLabel(pos)))
 	}
 
 	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptMajorAspects")))
 	for _, aspect := range chart.Aspects {
-		sb.WriteString(fmt.Sprintf("- %s %s %s %s %s (%s %.1f°)\n",
-			aspect.Body1.Symbol(), aspect.Body1.String(), aspect.Type.String(), aspect.Body2.Symbol(), aspect.Body2.String(), i18n.T("PromptOrb"), aspect.Orb))
+		sb.WriteString(fmt.Sprintf("- %s %s %s %s %s (%s %.1f°, %s)\n",
+			aspect.Body1.Symbol(), aspect.Body1.String(), aspect.Type.String(), aspect.Body2.Symbol(), aspect.Body2.String(), i18n.T("PromptOrb"), aspect.Orb, motionLabel(aspect)))
 	}
 
-	// Element distribution
-	elements := calculateElements(chart.Positions)
-	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptElementDist")))
-	sb.WriteString(fmt.Sprintf("- %s: "+i18n.T("ElementCount")+"\n", i18n.T("ElementFire"), elements[horoscope.Fire]))
-	sb.WriteString(fmt.Sprintf("- %s: "+i18n.T("ElementCount")+"\n", i18n.T("ElementEarth"), elements[horoscope.Earth]))
-	sb.WriteString(fmt.Sprintf("- %s: "+i18n.T("ElementCount")+"\n", i18n.T("ElementAir"), elements[horoscope.Air]))
-	sb.WriteString(fmt.Sprintf("- %s: "+i18n.T("ElementCount")+"\n", i18n.T("ElementWater"), elements[horoscope.Water]))
+	writeOverview(&sb, chart)
+	writeHouses(&sb, chart)
+	writeDignities(&sb, chart)
+	writePatterns(&sb, chart)
+	writeStars(&sb, chart)
+	writeSky(&sb, chart)
+	writeTimeLords(&sb, chart, time.Now())
+	writePlanetaryHours(&sb, chart, time.Now())
 
 	return sb.String()
 }
 
-func calculateElements(positions []position.Position) map[horoscope.Element]int {
-	elements := make(map[horoscope.Element]int)
-	for _, pos := range positions {
-		if pos.Body > position.Pluto {
+// writeOverview sums up the balance, hemispheres and shape of the chart
+func writeOverview(sb *strings.Builder, chart *horoscope.Chart) {
+	o := chart.Overview()
+	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptOverview")))
+
+	var parts []string
+	for e := horoscope.Fire; e <= horoscope.Water; e++ {
+		parts = append(parts, fmt.Sprintf("%s %g", i18n.T("Element"+e.String()), o.Elements[e]))
+	}
+	sb.WriteString(fmt.Sprintf("- %s: %s (%s: %s)\n", i18n.T("OverviewElements"), strings.Join(parts, ", "),
+		i18n.T("OverviewDominant"), i18n.T("Element"+o.Element.String())))
+
+	parts = parts[:0]
+	for m := horoscope.Cardinal; m <= horoscope.Mutable; m++ {
+		parts = append(parts, fmt.Sprintf("%s %g", i18n.T("Modality"+m.String()), o.Modalities[m]))
+	}
+	sb.WriteString(fmt.Sprintf("- %s: %s (%s: %s)\n", i18n.T("OverviewModalities"), strings.Join(parts, ", "),
+		i18n.T("OverviewDominant"), i18n.T("Modality"+o.Modality.String())))
+
+	if o.HasHouses {
+		sb.WriteString(fmt.Sprintf("- %s: %s %d, %s %d, %s %d, %s %d\n", i18n.T("OverviewHemispheres"),
+			i18n.T("OverviewEastern"), o.Eastern, i18n.T("OverviewWestern"), o.Western,
+			i18n.T("OverviewNorthern"), o.Northern, i18n.T("OverviewSouthern"), o.Southern))
+		sb.WriteString(fmt.Sprintf("- %s: I %d, II %d, III %d, IV %d\n", i18n.T("OverviewQuadrants"),
+			o.Quadrants[0], o.Quadrants[1], o.Quadrants[2], o.Quadrants[3]))
+	}
+
+	sb.WriteString(fmt.Sprintf("- %s: %s", i18n.T("OverviewShape"), i18n.T("Shape"+o.Shape.String())))
+	if o.HasLeader {
+		role := i18n.T("OverviewLeader")
+		if o.Shape == horoscope.Bucket {
+			role = i18n.T("OverviewHandle")
+		}
+		sb.WriteString(fmt.Sprintf(" (%s: %s %s)", role, o.Leader.Symbol(), o.Leader.String()))
+	}
+	sb.WriteString("\n")
+}
+
+// writeHouses lists the houses of relocated charts next to the natal ones
+func writeHouses(sb *strings.Builder, chart *horoscope.Chart) {
+	if chart.Relocation == nil || chart.Houses == nil {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptHouses")))
+	for _, pos := range chart.Positions {
+		house, natal := chart.BodyInHouse(pos.Body), chart.NatalHouse(pos.Body)
+		if house == 0 {
 			continue
 		}
-		zodiac := horoscope.LongitudeToZodiac(pos.EclipticLongitude)
-		elements[zodiac.Sign.Element()]++
+		sb.WriteString(fmt.Sprintf("- %s: %d / %d\n", pos.Body.String(), house, natal))
+	}
+}
+
+func writePatterns(sb *strings.Builder, chart *horoscope.Chart) {
+	patterns := chart.Patterns()
+	if len(patterns) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptPatterns")))
+	for _, p := range patterns {
+		names := make([]string, len(p.Bodies))
+		for i, b := range p.Bodies {
+			names[i] = b.Symbol() + " " + b.String()
+		}
+		label := p.Type.String()
+		switch p.Type {
+		case horoscope.StelliumSign:
+			label += " " + p.Sign.String()
+		case horoscope.StelliumHouse:
+			label += fmt.Sprintf(" %s %d", i18n.T("PatternHouse"), p.House)
+		}
+		sb.WriteString(fmt.Sprintf("- %s: %s", label, strings.Join(names, ", ")))
+		if p.HasApex {
+			sb.WriteString(fmt.Sprintf(" (%s %s)", i18n.T("PatternApex"), p.Apex.String()))
+		}
+		sb.WriteString(fmt.Sprintf(" [%.1f°]\n", p.Tightness))
+	}
+}
+
+func writeStars(sb *strings.Builder, chart *horoscope.Chart) {
+	if len(chart.StarContacts) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptFixedStars")))
+	for _, c := range chart.StarContacts {
+		if c.Type == horoscope.StarParan {
+			sb.WriteString(fmt.Sprintf("- %s (%s) paran %s (%s) [%.2f°]\n",
+				c.Star.Name, c.StarEvent, c.Point(), c.BodyEvent, c.Orb))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("- %s conjunct %s [%.2f°]\n", c.Star.Name, c.Point(), c.Orb))
+	}
+}
+
+func writeSky(sb *strings.Builder, chart *horoscope.Chart) {
+	var above []string
+	for _, s := range chart.Sky {
+		if s.AboveHorizon {
+			above = append(above, fmt.Sprintf("%s (%.0f°)", s.Body.String(), s.Altitude))
+		}
+	}
+	if len(above) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s: %s\n", i18n.T("PromptAboveHorizon"), strings.Join(above, ", ")))
+}
+
+func writeTimeLords(sb *strings.Builder, chart *horoscope.Chart, now time.Time) {
+	tl := chart.TimeLordsAt(now)
+	if !tl.HasProfections && !tl.HasFirdaria && len(tl.Fortune) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s (%s):\n", i18n.T("PromptTimeLords"), now.Format("2006-01-02")))
+	if tl.HasProfections {
+		sb.WriteString(fmt.Sprintf("- %s: %s, %s %d, %s %s\n", i18n.T("TimelineYear"),
+			tl.Year.Sign.String(), i18n.T("TimelineHouse"), tl.Year.House, i18n.T("PromptLordOfYear"), tl.Year.Lord.String()))
+		sb.WriteString(fmt.Sprintf("- %s: %s, %s %d, %s\n", i18n.T("TimelineMonth"),
+			tl.Month.Sign.String(), i18n.T("TimelineHouse"), tl.Month.House, tl.Month.Lord.String()))
+	}
+	if tl.HasFirdaria {
+		lords := tl.Firdaria.Lord.String()
+		if tl.FirdariaMinor.SubLord != tl.Firdaria.Lord {
+			lords += " / " + tl.FirdariaMinor.SubLord.String()
+		}
+		sb.WriteString(fmt.Sprintf("- %s: %s\n", i18n.T("TimelineFirdaria"), lords))
+	}
+	writeReleasing(sb, i18n.T("TimelineFortune"), tl.Fortune)
+	writeReleasing(sb, i18n.T("TimelineSpirit"), tl.Spirit)
+}
+
+// writePlanetaryHours lists the hours of the planetary day running now at
+// the chart place, for questions about the best time of the day
+func writePlanetaryHours(sb *strings.Builder, chart *horoscope.Chart, now time.Time) {
+	day, err := horoscope.PlanetaryDayAt(now, chart.Latitude, chart.Longitude)
+	if err != nil {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n%s (%s):\n", i18n.T("PromptPlanetaryHours"), now.Format("2006-01-02 15:04 MST")))
+	sb.WriteString(fmt.Sprintf("%s: %s %s\n", i18n.T("PromptDayRuler"), day.Ruler.Symbol(), day.Ruler.String()))
+	if hour, ok := day.HourAt(now); ok {
+		left := fmt.Sprintf("%d min", int(hour.Remaining(now).Round(time.Minute).Minutes()))
+		sb.WriteString(fmt.Sprintf("%s: %s %s (%s)\n", i18n.T("PromptCurrentHour"),
+			hour.Ruler.Symbol(), hour.Ruler.String(), fmt.Sprintf(i18n.T("PromptHourLeft"), left)))
+	}
+	for _, h := range day.Hours {
+		period := i18n.T("PromptDayHour")
+		if h.Night {
+			period = i18n.T("PromptNightHour")
+		}
+		sb.WriteString(fmt.Sprintf("- %s-%s %s %s (%s %d)\n", h.Start.In(now.Location()).Format("15:04"),
+			h.End.In(now.Location()).Format("15:04"), h.Ruler.Symbol(), h.Ruler.String(), period, h.Number))
+	}
+}
+
+func writeReleasing(sb *strings.Builder, label string, periods []horoscope.ReleasingPeriod) {
+	for _, p := range periods {
+		sb.WriteString(fmt.Sprintf("- %s (%s) L%d: %s", i18n.T("TimelineReleasing"), label, p.Level, p.Sign.String()))
+		if p.Peak {
+			sb.WriteString(" [" + i18n.T("TimelinePeak") + "]")
+		}
+		if p.LoosingOfBond {
+			sb.WriteString(" [" + i18n.T("TimelineLoosing") + "]")
+		}
+		sb.WriteString("\n")
+	}
+}
+
+func writeDignities(sb *strings.Builder, chart *horoscope.Chart) {
+	sb.WriteString(fmt.Sprintf("\n%s:\n", i18n.T("PromptDignities")))
+	for _, d := range chart.DignityTable() {
+		names := make([]string, 0)
+		for _, t := range d.List() {
+			names = append(names, t.String())
+		}
+		sb.WriteString(fmt.Sprintf("- %s %s in %s: %+d (%s)\n",
+			d.Body.Symbol(), d.Body.String(), d.Sign.String(), d.Score, strings.Join(names, ", ")))
+	}
+
+	if ruler, ok := chart.ChartRuler(); ok {
+		sb.WriteString(fmt.Sprintf("%s: %s %s\n", i18n.T("PromptChartRuler"), ruler.Symbol(), ruler.String()))
+	}
+	if final, ok := chart.FinalDispositor(); ok {
+		sb.WriteString(fmt.Sprintf("%s: %s %s\n", i18n.T("PromptFinalDispositor"), final.Symbol(), final.String()))
+	}
+	if receptions := chart.MutualReceptions(); len(receptions) > 0 {
+		sb.WriteString(fmt.Sprintf("%s:\n", i18n.T("PromptReceptions")))
+		for _, r := range receptions {
+			sb.WriteString(fmt.Sprintf("- %s %s / %s %s (%s)\n",
+				r.Body1.Symbol(), r.Body1.String(), r.Body2.Symbol(), r.Body2.String(), r.Type.String()))
+		}
+	}
+}
+
+func outOfBoundsLabel(pos position.Position) string {
+	if pos.IsOutOfBounds() {
+		return i18n.T("PromptOutOfBounds")
+	}
+	return ""
+}
+
+func stationaryLabel(pos position.Position) string {
+	if pos.Stationary {
+		return i18n.T("PromptStationary")
+	}
+	return ""
+}
+
+func motionLabel(aspect horoscope.Aspect) string {
+	if aspect.Applying {
+		return i18n.T("PromptApplying")
 	}
-	return elements
+	return i18n.T("PromptSeparating")
 }
 
 func retrogradeLabel(isRetro bool) string {
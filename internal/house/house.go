@@ -48,6 +48,16 @@ func (c *Cusps) GetHouse(longitude float64) int {
 	return 1
 }
 
+// GetAscendant returns the ecliptic longitude of the Ascendant
+func (c *Cusps) GetAscendant() float64 {
+	return c.Ascendant
+}
+
+// GetMC returns the ecliptic longitude of the Midheaven
+func (c *Cusps) GetMC() float64 {
+	return c.MC
+}
+
 // Calculate computes house cusps using the Placidus system
 func Calculate(latitude, longitude float64, t time.Time) *Cusps {
 	asc := position.CalculateAscendant(latitude, longitude, t)
@@ -81,17 +91,12 @@ func Calculate(latitude, longitude float64, t time.Time) *Cusps {
 		Sign: horoscope.LongitudeToZodiac(position.NormalizeAngle(asc + 180)).Sign,
 	}
 
-	// Calculate intermediate cusps using Placidus method
-	// Houses 11, 12, 2, 3 and their opposites 5, 6, 8, 9
-
-	// House 11: 1/3 of semi-arc from MC
-	cusps.Houses[10] = calculateCusp(11, ramc, 30, latRad, oblRad)
-	// House 12: 2/3 of semi-arc from MC
-	cusps.Houses[11] = calculateCusp(12, ramc, 60, latRad, oblRad)
-	// House 2: 2/3 of semi-arc from IC
-	cusps.Houses[1] = calculateCusp(2, ramc, 120, latRad, oblRad)
-	// House 3: 1/3 of semi-arc from IC
-	cusps.Houses[2] = calculateCusp(3, ramc, 150, latRad, oblRad)
+	// Intermediate cusps by trisecting the semi-arcs (Placidus):
+	// houses 11, 12, 2, 3 and their opposites 5, 6, 8, 9
+	cusps.Houses[10] = calculateCusp(11, ramc, latRad, oblRad)
+	cusps.Houses[11] = calculateCusp(12, ramc, latRad, oblRad)
+	cusps.Houses[1] = calculateCusp(2, ramc, latRad, oblRad)
+	cusps.Houses[2] = calculateCusp(3, ramc, latRad, oblRad)
 
 	// Opposite houses
 	cusps.Houses[4] = House{
@@ -114,57 +119,54 @@ func Calculate(latitude, longitude float64, t time.Time) *Cusps {
 	return cusps
 }
 
-// calculateCusp calculates an intermediate house cusp using Placidus method
-func calculateCusp(houseNum int, ramc, offset, lat, obl float64) House {
-	ra := position.NormalizeAngle(ramc + offset)
-	raRad := position.DegreesToRadians(ra)
+// placidusCusps gives, for each intermediate house, the right ascension
+// of its cusp from the RAMC as a function of the diurnal semi-arc of the
+// cusp: a third or two thirds of the way through the diurnal semi-arc
+// above the horizon, or through the nocturnal semi-arc (180° - dsa) below
+var placidusCusps = map[int]func(dsa float64) float64{
+	11: func(dsa float64) float64 { return dsa / 3 },
+	12: func(dsa float64) float64 { return 2 * dsa / 3 },
+	2:  func(dsa float64) float64 { return dsa + (180-dsa)/3 },
+	3:  func(dsa float64) float64 { return dsa + 2*(180-dsa)/3 },
+}
 
-	// Iterative calculation for Placidus cusps
-	var lon float64
-	for i := 0; i < 20; i++ {
-		// Calculate declination of the point
-		decl := math.Asin(math.Sin(obl) * math.Sin(raRad))
-
-		// Calculate semi-arc
-		h := math.Acos(-math.Tan(lat) * math.Tan(decl))
-		if math.IsNaN(h) {
-			// Fall back to equal houses for extreme latitudes
-			lon = ra
+// calculateCusp calculates an intermediate Placidus cusp: the point of the
+// ecliptic whose hour angle is the house's fraction of its own semi-arc.
+// The semi-arc depends on the point's declination, hence the iteration.
+func calculateCusp(houseNum int, ramc, lat, obl float64) House {
+	cuspRA := placidusCusps[houseNum]
+
+	// Start from the equal division of the equator, which is exact at
+	// the equator where every semi-arc is 90°
+	ra := ramc + cuspRA(90)
+	lon := raToLongitude(ra, obl)
+	for i := 0; i < 50; i++ {
+		decl := math.Asin(math.Sin(obl) * math.Sin(position.DegreesToRadians(lon)))
+		x := -math.Tan(lat) * math.Tan(decl)
+		if math.Abs(x) > 1 {
+			// Circumpolar cusps are undefined in Placidus beyond the polar
+			// circles; keep the equal division of the equator
+			lon = raToLongitude(ramc+cuspRA(90), obl)
 			break
 		}
-
-		// Calculate fraction based on house
-		var f float64
-		switch houseNum {
-		case 11:
-			f = 1.0 / 3.0
-		case 12:
-			f = 2.0 / 3.0
-		case 2:
-			f = 2.0 / 3.0
-		case 3:
-			f = 1.0 / 3.0
-		}
-
-		// Adjust RA
-		newRA := ramc + offset
-		if houseNum <= 3 || houseNum >= 10 {
-			newRA = ramc + f*position.RadiansToDegrees(h)*2
+		next := ramc + cuspRA(position.RadiansToDegrees(math.Acos(x)))
+		lon = raToLongitude(next, obl)
+		if math.Abs(position.NormalizeMotion(next-ra)) < 1e-9 {
+			break
 		}
-
-		raRad = position.DegreesToRadians(position.NormalizeAngle(newRA))
-
-		// Convert RA to ecliptic longitude
-		lon = position.RadiansToDegrees(math.Atan2(
-			math.Sin(raRad)*math.Cos(obl)+math.Tan(decl)*math.Sin(obl),
-			math.Cos(raRad),
-		))
+		ra = next
 	}
 
-	lon = position.NormalizeAngle(lon)
 	return House{
 		Number: houseNum,
 		Cusp:   lon,
 		Sign:   horoscope.LongitudeToZodiac(lon).Sign,
 	}
 }
+
+// raToLongitude returns the ecliptic longitude of the point of the ecliptic
+// with the given right ascension (degrees, obliquity in radians)
+func raToLongitude(ra, obl float64) float64 {
+	raRad := position.DegreesToRadians(ra)
+	return position.NormalizeAngle(position.RadiansToDegrees(math.Atan2(math.Sin(raRad), math.Cos(raRad)*math.Cos(obl))))
+}
@@ -0,0 +1,39 @@
+package house
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Intermediate cusps from Raphael's Tables of Houses for London (51°32'N)
+// at sidereal time 0h, given to the whole degree: 11th 9° Taurus, 12th 22°
+// Gemini, 2nd 12° Leo, 3rd 3° Virgo
+func TestPlacidusCuspsLondon(t *testing.T) {
+	lat := position.DegreesToRadians(51 + 32.0/60)
+	obl := position.DegreesToRadians(position.Obliquity)
+	want := map[int]float64{11: 39, 12: 82, 2: 132, 3: 153}
+	for house, lon := range want {
+		got := calculateCusp(house, 0, lat, obl).Cusp
+		if math.Abs(position.NormalizeMotion(got-lon)) > 1 {
+			t.Errorf("house %d: cusp %.2f, want %g", house, got, lon)
+		}
+	}
+}
+
+// On the equator every semi-arc is 90°, so the cusps divide the equator
+// into equal parts from the RAMC
+func TestPlacidusCuspsEquator(t *testing.T) {
+	obl := position.DegreesToRadians(position.Obliquity)
+	offsets := map[int]float64{11: 30, 12: 60, 2: 120, 3: 150}
+	for _, ramc := range []float64{0, 75, 200} {
+		for house, offset := range offsets {
+			got := calculateCusp(house, ramc, 0, obl).Cusp
+			want := raToLongitude(ramc+offset, obl)
+			if math.Abs(position.NormalizeMotion(got-want)) > 1e-6 {
+				t.Errorf("RAMC %g, house %d: cusp %.4f, want %.4f", ramc, house, got, want)
+			}
+		}
+	}
+}
@@ -3,16 +3,27 @@ package i18n
 var messages = map[Lang]map[string]string{
 	EN: {
 		// Form
-		"FormTitle":                "Consult the Oracle",
-		"FormBirthDate":            "Birth date",
-		"FormBirthDateDesc":        "Format: DD/MM/YYYY",
-		"FormBirthDatePlaceholder": "21/03/1990",
-		"FormTransitDate":          "Transit date",
-		"FormTransitDateDesc":      "For predictions (DD/MM/YYYY)",
+		"FormTitle":                  "Consult the Oracle",
+		"FormBirthDate":              "Birth date",
+		"FormBirthDateDesc":          "Format: DD/MM/YYYY",
+		"FormBirthDatePlaceholder":   "21/03/1990",
+		"FormTransitDate":            "Transit date",
+		"FormTransitDateDesc":        "For predictions (DD/MM/YYYY)",
 		"FormTransitDatePlaceholder": "01/01/2025",
-		"FormQuestion":             "Ask the oracle your question",
-		"FormQuestionDesc":         "Ex: Should I accept this job? Is it the right time to...?",
-		"FormQuestionPlaceholder":  "What's on your mind?",
+		"FormQuestion":               "Ask the oracle your question",
+		"FormQuestionDesc":           "Ex: Should I accept this job? Is it the right time to...?",
+		"FormQuestionPlaceholder":    "What's on your mind?",
+		"FormRelocation":             "Relocation (optional)",
+		"FormRelocationDesc":         "City to cast the houses for, e.g. Lisbon, Portugal",
+		"FormRelocationPlaceholder":  "Leave empty for the birth place",
+		"FormElection":               "Election (optional)",
+		"FormElectionDesc":           "Criteria searched over the 90 days from the transit date, e.g. moon waxing, moon not void, venus trine natal sun",
+		"FormElectionPlaceholder":    "Leave empty to skip the search",
+		"FormSex":                    "Sex (optional)",
+		"FormSexDesc":                "Sets the direction of the BaZi luck pillars",
+		"FormSexUnknown":             "Not given",
+		"FormSexFemale":              "Female",
+		"FormSexMale":                "Male",
 
 		// Validation
 		"ValidationRequired":      "date required",
@@ -28,13 +39,17 @@ var messages = map[Lang]map[string]string{
 		"StatusWaitingData":       "Waiting for data...",
 		"StatusWaitingNatal":      "Waiting for natal chart...",
 		"StatusError":             "Error: ",
+		"StatusExported":          "Chart exported to ",
+		"StatusExportError":       "Export error: ",
 
 		// Missing city
 		"MissingCityError": "ASTRAL_CITY variable missing",
 		"MissingCityHint":  "Set the environment variable:",
 
 		// Header
-		"HeaderTitle": "MY ORACLE",
+		"HeaderTitle":         "MY ORACLE",
+		"HeaderPlanetaryDay":  "day %s",
+		"HeaderPlanetaryHour": "%s hour",
 
 		// Interpretation
 		"InterpTitle":   "Interpretation",
@@ -42,28 +57,182 @@ var messages = map[Lang]map[string]string{
 		"InterpError":   "[Error]",
 
 		// Positions
-		"PositionPlanet":   "Planet",
-		"PositionNatal":    "Natal",
-		"PositionTransit":  "Transit",
-		"PositionPosition": "Position",
-		"PositionTransits": "Transits",
-		"PositionBoth":     "Natal / Transits",
+		"PositionPlanet":      "Planet",
+		"PositionNatal":       "Natal",
+		"PositionTransit":     "Transit",
+		"PositionPosition":    "Position",
+		"PositionTransits":    "Transits",
+		"PositionBoth":        "Natal / Transits",
+		"PositionDeclination": "Decl.",
+		"PositionOOB":         "OOB",
+		"PositionSpeed":       "Speed",
+		"SpeedFast":           "fast",
+		"SpeedSlow":           "slow",
+		"SpeedStationary":     "stat",
+
+		// Dignities
+		"DignityTitle":           "Essential dignities",
+		"DignitySign":            "Sign",
+		"DignityScore":           "Score",
+		"DignityDomicileShort":   "Dom",
+		"DignityExaltationShort": "Exa",
+		"DignityTriplicityShort": "Tri",
+		"DignityTermShort":       "Ter",
+		"DignityFaceShort":       "Fac",
+		"DignityDetrimentShort":  "Det",
+		"DignityFallShort":       "Fal",
+		"DignityChartRuler":      "Chart ruler",
+		"DignityFinalDispositor": "Final dispositor",
+		"DignityReceptions":      "Mutual receptions",
+		"DignityDispositors":     "Dispositor chains",
+		"DignityNone":            "none",
+
+		// Patterns
+		"PatternTitle": "Aspect patterns",
+		"PatternNone":  "No major pattern in this chart",
+		"PatternApex":  "apex",
+		"PatternHouse": "house",
+		"PatternSpan":  "span",
+
+		// Fixed stars
+		"StarTitle":           "Fixed stars",
+		"StarNone":            "No fixed star contact within orb",
+		"StarConjunctions":    "Conjunctions",
+		"StarParans":          "Parans",
+		"StarRising":          "rising",
+		"StarCulminating":     "culminating",
+		"StarSetting":         "setting",
+		"StarAntiCulminating": "anti-culminating",
+
+		// Sky
+		"SkyTitle":    "Sky at birth",
+		"SkyAltitude": "Alt.",
+		"SkyAzimuth":  "Az.",
+		"SkyRise":     "Rise",
+		"SkyTransit":  "Transit",
+		"SkySet":      "Set",
+
+		// Heliocentric
+		"HelioTitle":        "Geocentric / Heliocentric",
+		"HelioGeocentric":   "Geocentric",
+		"HelioHeliocentric": "Heliocentric",
+
+		// Timeline
+		"TimelineTitle":       "Time lords",
+		"TimelineProfections": "Profections",
+		"TimelineYear":        "Year",
+		"TimelineMonth":       "Month",
+		"TimelineAge":         "age",
+		"TimelineHouse":       "house",
+		"TimelineFirdaria":    "Firdaria",
+		"TimelineReleasing":   "Zodiacal releasing",
+		"TimelineFortune":     "Fortune",
+		"TimelineSpirit":      "Spirit",
+		"TimelinePeak":        "peak",
+		"TimelineLoosing":     "loosing of the bond",
+		"TimelineUntil":       "until",
+
+		// Relocation
+		"RelocationTitle":     "Natal / Relocated houses",
+		"RelocationNone":      "No relocation: enter a city in the Relocation field of the form",
+		"RelocationNatal":     "Natal",
+		"RelocationRelocated": "Relocated",
+
+		// Election
+		"ElectionTitle":     "Election",
+		"ElectionNone":      "No election: enter criteria in the Election field of the form",
+		"ElectionSearching": "Searching the ephemeris...",
+		"ElectionNoWindow":  "No window matches every criterion",
+		"ElectionError":     "Search error: ",
+		"ElectionStart":     "Start",
+		"ElectionEnd":       "End",
+		"ElectionPeak":      "Peak",
+		"ElectionScore":     "Score",
+
+		// Overview
+		"OverviewTitle":       "Chart overview",
+		"OverviewBalance":     "Weighted balance (luminaries and angles count double)",
+		"OverviewElements":    "Elements",
+		"OverviewModalities":  "Modalities",
+		"OverviewDominant":    "dominant",
+		"OverviewHemispheres": "Hemispheres",
+		"OverviewEastern":     "Eastern",
+		"OverviewWestern":     "Western",
+		"OverviewNorthern":    "Northern (below horizon)",
+		"OverviewSouthern":    "Southern (above horizon)",
+		"OverviewQuadrants":   "Quadrants",
+		"OverviewNoHouses":    "No houses: hemispheres unknown",
+		"OverviewShape":       "Shape",
+		"OverviewLeader":      "leading planet",
+		"OverviewHandle":      "handle",
+		"ShapeBundle":         "Bundle",
+		"ShapeBundleDesc":     "Every planet within a trine: a concentrated, specialised life.",
+		"ShapeBowl":           "Bowl",
+		"ShapeBowlDesc":       "Every planet within half the wheel: self-contained, driven to fill the empty half.",
+		"ShapeBucket":         "Bucket",
+		"ShapeBucketDesc":     "A bowl with a single planet opposite, the handle, channelling all the energy.",
+		"ShapeLocomotive":     "Locomotive",
+		"ShapeLocomotiveDesc": "Planets over two thirds of the wheel, an empty trine: self-driven momentum.",
+		"ShapeSeesaw":         "Seesaw",
+		"ShapeSeesawDesc":     "Two opposing groups: weighing options, balancing opposite pulls.",
+		"ShapeSplay":          "Splay",
+		"ShapeSplayDesc":      "Irregular groups: individualistic, resisting to be pigeonholed.",
+		"ShapeSplash":         "Splash",
+		"ShapeSplashDesc":     "Planets spread around the wheel: wide-ranging, scattered interests.",
+
+		// BaZi
+		"BaZiTitle":         "Four Pillars (BaZi)",
+		"BaZiYear":          "Year",
+		"BaZiMonth":         "Month",
+		"BaZiDay":           "Day",
+		"BaZiHour":          "Hour",
+		"BaZiStem":          "Stem",
+		"BaZiBranch":        "Branch",
+		"BaZiDayMaster":     "Day master",
+		"BaZiSolarTime":     "Solar time",
+		"BaZiLuck":          "Luck pillars",
+		"BaZiForward":       "forward",
+		"BaZiBackward":      "backward",
+		"BaZiAge":           "Age",
+		"BaZiPeriod":        "Period",
+		"BaZiNoSex":         "Choose a sex in the form to see the luck pillars.",
+		"BaZiNoTime":        "The form gives no birth time: the hour pillar uses the current time of day.",
+		"BaZiAnimalRat":     "Rat",
+		"BaZiAnimalOx":      "Ox",
+		"BaZiAnimalTiger":   "Tiger",
+		"BaZiAnimalRabbit":  "Rabbit",
+		"BaZiAnimalDragon":  "Dragon",
+		"BaZiAnimalSnake":   "Snake",
+		"BaZiAnimalHorse":   "Horse",
+		"BaZiAnimalGoat":    "Goat",
+		"BaZiAnimalMonkey":  "Monkey",
+		"BaZiAnimalRooster": "Rooster",
+		"BaZiAnimalDog":     "Dog",
+		"BaZiAnimalPig":     "Pig",
+		"BaZiElementWood":   "Wood",
+		"BaZiElementFire":   "Fire",
+		"BaZiElementEarth":  "Earth",
+		"BaZiElementMetal":  "Metal",
+		"BaZiElementWater":  "Water",
 
 		// Wheel
-		"WheelPlaceholder": "[ Zodiac wheel ]\n(Kitty/resvg required)",
 
 		// Navigation
 		"NavNavigate":    " navigate",
 		"NavScroll":      " scroll",
 		"NavNewQuestion": " new question",
 		"NavQuit":        " quit",
+		"NavPanels":      " panels",
+		"NavExport":      " export",
 
 		// Elements
-		"ElementFire":  "Fire",
-		"ElementEarth": "Earth",
-		"ElementAir":   "Air",
-		"ElementWater": "Water",
-		"ElementCount": "%d planets",
+		"ElementFire":      "Fire",
+		"ElementEarth":     "Earth",
+		"ElementAir":       "Air",
+		"ElementWater":     "Water",
+		"ModalityCardinal": "Cardinal",
+		"ModalityFixed":    "Fixed",
+		"ModalityMutable":  "Mutable",
 
 		// Weekdays
 		"WeekdaySunday":    "Sunday",
@@ -84,30 +253,62 @@ var messages = map[Lang]map[string]string{
 		"PromptLocation":        "Location",
 		"PromptPlanetPositions": "Planetary positions",
 		"PromptMajorAspects":    "Major aspects",
-		"PromptElementDist":     "Element distribution",
 		"PromptRetrograde":      " (RETROGRADE)",
 		"PromptOrb":             "orb",
+		"PromptDignities":       "Essential dignities (traditional planets)",
+		"PromptChartRuler":      "Chart ruler",
+		"PromptFinalDispositor": "Final dispositor",
+		"PromptReceptions":      "Mutual receptions",
+		"PromptPatterns":        "Aspect patterns",
+		"PromptFixedStars":      "Fixed star contacts",
+		"PromptDeclination":     "declination",
+		"PromptOutOfBounds":     " (OUT OF BOUNDS)",
+		"PromptAboveHorizon":    "Planets above the horizon at birth",
+		"PromptStationary":      " (STATIONARY)",
+		"PromptApplying":        "applying",
+		"PromptSeparating":      "separating",
+		"PromptTimeLords":       "Current time lords",
+		"PromptLordOfYear":      "lord of the year",
+		"PromptRelocated":       "RELOCATED CHART: houses and angles are cast for the location above, not for the birth place",
+		"PromptBirthPlace":      "Birth place",
+		"PromptHouses":          "House placements (relocated house / natal house)",
+		"PromptPlanetaryHours":  "Planetary hours of the current day at the chart place",
+		"PromptDayRuler":        "Day ruler",
+		"PromptCurrentHour":     "Current hour",
+		"PromptHourLeft":        "%s left",
+		"PromptDayHour":         "day",
+		"PromptNightHour":       "night",
+		"PromptOverview":        "Chart overview (weighted, luminaries and angles count double)",
 
 		// Preflight checks
-		"PreflightOpenAIHelp":  "export OPENAI_API_KEY=\"sk-...\"",
-		"PreflightCityHelp":    "export ASTRAL_CITY=\"Paris, France\"",
-		"PreflightTerminal":    "Terminal (Kitty graphics)",
-		"PreflightTerminalHelp": "Use Kitty, Ghostty or WezTerm terminal",
-		"PreflightResvgHelp":   "cargo install resvg\nor: brew install resvg",
+		"PreflightOpenAIHelp": "export OPENAI_API_KEY=\"sk-...\"",
+		"PreflightCityHelp":   "export ASTRAL_CITY=\"Paris, France\"",
+		"PreflightResvgHelp":  "cargo install resvg\nor: brew install resvg",
 	},
 
 	FR: {
 		// Form
-		"FormTitle":                "Consulter l'Oracle",
-		"FormBirthDate":            "Date de naissance",
-		"FormBirthDateDesc":        "Format: JJ/MM/AAAA",
-		"FormBirthDatePlaceholder": "21/03/1990",
-		"FormTransitDate":          "Date de transit",
-		"FormTransitDateDesc":      "Pour les prédictions (JJ/MM/AAAA)",
+		"FormTitle":                  "Consulter l'Oracle",
+		"FormBirthDate":              "Date de naissance",
+		"FormBirthDateDesc":          "Format: JJ/MM/AAAA",
+		"FormBirthDatePlaceholder":   "21/03/1990",
+		"FormTransitDate":            "Date de transit",
+		"FormTransitDateDesc":        "Pour les prédictions (JJ/MM/AAAA)",
 		"FormTransitDatePlaceholder": "01/01/2025",
-		"FormQuestion":             "Pose ta question à l'oracle",
-		"FormQuestionDesc":         "Ex: Dois-je accepter ce job? C'est le bon moment pour...?",
-		"FormQuestionPlaceholder":  "Qu'est-ce qui te tracasse?",
+		"FormQuestion":               "Pose ta question à l'oracle",
+		"FormQuestionDesc":           "Ex: Dois-je accepter ce job? C'est le bon moment pour...?",
+		"FormQuestionPlaceholder":    "Qu'est-ce qui te tracasse?",
+		"FormRelocation":             "Relocalisation (facultatif)",
+		"FormRelocationDesc":         "Ville pour laquelle calculer les maisons, ex. Lisbonne, Portugal",
+		"FormRelocationPlaceholder":  "Laisser vide pour le lieu de naissance",
+		"FormElection":               "Élection (facultatif)",
+		"FormElectionDesc":           "Critères cherchés sur les 90 jours à partir de la date de transit, ex. moon waxing, moon not void, venus trine natal sun",
+		"FormElectionPlaceholder":    "Laisser vide pour ne pas chercher",
+		"FormSex":                    "Sexe (facultatif)",
+		"FormSexDesc":                "Donne le sens des piliers de chance BaZi",
+		"FormSexUnknown":             "Non précisé",
+		"FormSexFemale":              "Femme",
+		"FormSexMale":                "Homme",
 
 		// Validation
 		"ValidationRequired":      "date requise",
@@ -123,13 +324,17 @@ var messages = map[Lang]map[string]string{
 		"StatusWaitingData":       "En attente des données...",
 		"StatusWaitingNatal":      "En attente du thème natal...",
 		"StatusError":             "Erreur: ",
+		"StatusExported":          "Thème exporté vers ",
+		"StatusExportError":       "Erreur d'export: ",
 
 		// Missing city
 		"MissingCityError": "Variable ASTRAL_CITY manquante",
 		"MissingCityHint":  "Définissez la variable d'environnement:",
 
 		// Header
-		"HeaderTitle": "MON ORACLE",
+		"HeaderTitle":         "MON ORACLE",
+		"HeaderPlanetaryDay":  "jour %s",
+		"HeaderPlanetaryHour": "heure %s",
 
 		// Interpretation
 		"InterpTitle":   "Interprétation",
@@ -137,28 +342,182 @@ var messages = map[Lang]map[string]string{
 		"InterpError":   "[Erreur]",
 
 		// Positions
-		"PositionPlanet":   "Planète",
-		"PositionNatal":    "Natal",
-		"PositionTransit":  "Transit",
-		"PositionPosition": "Position",
-		"PositionTransits": "Transits",
-		"PositionBoth":     "Natal / Transits",
+		"PositionPlanet":      "Planète",
+		"PositionNatal":       "Natal",
+		"PositionTransit":     "Transit",
+		"PositionPosition":    "Position",
+		"PositionTransits":    "Transits",
+		"PositionBoth":        "Natal / Transits",
+		"PositionDeclination": "Décl.",
+		"PositionOOB":         "HL",
+		"PositionSpeed":       "Vitesse",
+		"SpeedFast":           "rap.",
+		"SpeedSlow":           "lent",
+		"SpeedStationary":     "stat",
+
+		// Dignities
+		"DignityTitle":           "Dignités essentielles",
+		"DignitySign":            "Signe",
+		"DignityScore":           "Score",
+		"DignityDomicileShort":   "Dom",
+		"DignityExaltationShort": "Exa",
+		"DignityTriplicityShort": "Tri",
+		"DignityTermShort":       "Ter",
+		"DignityFaceShort":       "Déc",
+		"DignityDetrimentShort":  "Exi",
+		"DignityFallShort":       "Chu",
+		"DignityChartRuler":      "Maître du thème",
+		"DignityFinalDispositor": "Dispositeur final",
+		"DignityReceptions":      "Réceptions mutuelles",
+		"DignityDispositors":     "Chaînes de dispositeurs",
+		"DignityNone":            "aucun",
+
+		// Patterns
+		"PatternTitle": "Configurations",
+		"PatternNone":  "Aucune configuration majeure dans ce thème",
+		"PatternApex":  "apex",
+		"PatternHouse": "maison",
+		"PatternSpan":  "étendue",
+
+		// Fixed stars
+		"StarTitle":           "Étoiles fixes",
+		"StarNone":            "Aucun contact d'étoile fixe dans l'orbe",
+		"StarConjunctions":    "Conjonctions",
+		"StarParans":          "Parans",
+		"StarRising":          "se lève",
+		"StarCulminating":     "culmine",
+		"StarSetting":         "se couche",
+		"StarAntiCulminating": "anti-culmine",
+
+		// Sky
+		"SkyTitle":    "Ciel de naissance",
+		"SkyAltitude": "Haut.",
+		"SkyAzimuth":  "Az.",
+		"SkyRise":     "Lever",
+		"SkyTransit":  "Culmin.",
+		"SkySet":      "Coucher",
+
+		// Heliocentric
+		"HelioTitle":        "Géocentrique / Héliocentrique",
+		"HelioGeocentric":   "Géocentrique",
+		"HelioHeliocentric": "Héliocentrique",
+
+		// Timeline
+		"TimelineTitle":       "Maîtres du temps",
+		"TimelineProfections": "Profections",
+		"TimelineYear":        "Année",
+		"TimelineMonth":       "Mois",
+		"TimelineAge":         "âge",
+		"TimelineHouse":       "maison",
+		"TimelineFirdaria":    "Firdaria",
+		"TimelineReleasing":   "Libération zodiacale",
+		"TimelineFortune":     "Fortune",
+		"TimelineSpirit":      "Esprit",
+		"TimelinePeak":        "pic",
+		"TimelineLoosing":     "déliement du lien",
+		"TimelineUntil":       "jusqu'au",
+
+		// Relocation
+		"RelocationTitle":     "Maisons natales / relocalisées",
+		"RelocationNone":      "Pas de relocalisation : indiquer une ville dans le champ Relocalisation du formulaire",
+		"RelocationNatal":     "Natal",
+		"RelocationRelocated": "Relocalisé",
+
+		// Election
+		"ElectionTitle":     "Élection",
+		"ElectionNone":      "Pas d'élection : indiquer des critères dans le champ Élection du formulaire",
+		"ElectionSearching": "Recherche dans les éphémérides...",
+		"ElectionNoWindow":  "Aucune fenêtre ne remplit tous les critères",
+		"ElectionError":     "Erreur de recherche : ",
+		"ElectionStart":     "Début",
+		"ElectionEnd":       "Fin",
+		"ElectionPeak":      "Pic",
+		"ElectionScore":     "Score",
+
+		// Overview
+		"OverviewTitle":       "Vue d'ensemble du thème",
+		"OverviewBalance":     "Équilibre pondéré (luminaires et angles comptent double)",
+		"OverviewElements":    "Éléments",
+		"OverviewModalities":  "Modalités",
+		"OverviewDominant":    "dominant",
+		"OverviewHemispheres": "Hémisphères",
+		"OverviewEastern":     "Est",
+		"OverviewWestern":     "Ouest",
+		"OverviewNorthern":    "Nord (sous l'horizon)",
+		"OverviewSouthern":    "Sud (au-dessus de l'horizon)",
+		"OverviewQuadrants":   "Quadrants",
+		"OverviewNoHouses":    "Pas de maisons : hémisphères inconnus",
+		"OverviewShape":       "Forme",
+		"OverviewLeader":      "planète meneuse",
+		"OverviewHandle":      "anse",
+		"ShapeBundle":         "Faisceau",
+		"ShapeBundleDesc":     "Toutes les planètes dans un trigone : une vie concentrée et spécialisée.",
+		"ShapeBowl":           "Bol",
+		"ShapeBowlDesc":       "Toutes les planètes dans une moitié de la roue : autonome, poussé à remplir la moitié vide.",
+		"ShapeBucket":         "Seau",
+		"ShapeBucketDesc":     "Un bol avec une planète isolée en face, l'anse, qui canalise toute l'énergie.",
+		"ShapeLocomotive":     "Locomotive",
+		"ShapeLocomotiveDesc": "Planètes sur les deux tiers de la roue, un trigone vide : un élan autonome.",
+		"ShapeSeesaw":         "Balançoire",
+		"ShapeSeesawDesc":     "Deux groupes opposés : peser les options, équilibrer des forces contraires.",
+		"ShapeSplay":          "Éventail",
+		"ShapeSplayDesc":      "Groupes irréguliers : individualiste, refuse d'être catalogué.",
+		"ShapeSplash":         "Éclaboussure",
+		"ShapeSplashDesc":     "Planètes réparties sur la roue : intérêts nombreux et dispersés.",
+
+		// BaZi
+		"BaZiTitle":         "Quatre Piliers (BaZi)",
+		"BaZiYear":          "Année",
+		"BaZiMonth":         "Mois",
+		"BaZiDay":           "Jour",
+		"BaZiHour":          "Heure",
+		"BaZiStem":          "Tronc",
+		"BaZiBranch":        "Branche",
+		"BaZiDayMaster":     "Maître du jour",
+		"BaZiSolarTime":     "Heure solaire",
+		"BaZiLuck":          "Piliers de chance",
+		"BaZiForward":       "sens direct",
+		"BaZiBackward":      "sens inverse",
+		"BaZiAge":           "Âge",
+		"BaZiPeriod":        "Période",
+		"BaZiNoSex":         "Choisissez un sexe dans le formulaire pour voir les piliers de chance.",
+		"BaZiNoTime":        "Le formulaire ne donne pas d'heure de naissance : le pilier de l'heure suit l'heure actuelle.",
+		"BaZiAnimalRat":     "Rat",
+		"BaZiAnimalOx":      "Buffle",
+		"BaZiAnimalTiger":   "Tigre",
+		"BaZiAnimalRabbit":  "Lapin",
+		"BaZiAnimalDragon":  "Dragon",
+		"BaZiAnimalSnake":   "Serpent",
+		"BaZiAnimalHorse":   "Cheval",
+		"BaZiAnimalGoat":    "Chèvre",
+		"BaZiAnimalMonkey":  "Singe",
+		"BaZiAnimalRooster": "Coq",
+		"BaZiAnimalDog":     "Chien",
+		"BaZiAnimalPig":     "Cochon",
+		"BaZiElementWood":   "Bois",
+		"BaZiElementFire":   "Feu",
+		"BaZiElementEarth":  "Terre",
+		"BaZiElementMetal":  "Métal",
+		"BaZiElementWater":  "Eau",
 
 		// Wheel
-		"WheelPlaceholder": "[ Roue zodiacale ]\n(Kitty/resvg requis)",
 
 		// Navigation
 		"NavNavigate":    " naviguer",
 		"NavScroll":      " défiler",
 		"NavNewQuestion": " nouvelle question",
 		"NavQuit":        " quitter",
+		"NavPanels":      " panneaux",
+		"NavExport":      " exporter",
 
 		// Elements
-		"ElementFire":  "Feu",
-		"ElementEarth": "Terre",
-		"ElementAir":   "Air",
-		"ElementWater": "Eau",
-		"ElementCount": "%d planètes",
+		"ElementFire":      "Feu",
+		"ElementEarth":     "Terre",
+		"ElementAir":       "Air",
+		"ElementWater":     "Eau",
+		"ModalityCardinal": "Cardinal",
+		"ModalityFixed":    "Fixe",
+		"ModalityMutable":  "Mutable",
 
 		// Weekdays
 		"WeekdaySunday":    "Dimanche",
@@ -179,30 +538,62 @@ var messages = map[Lang]map[string]string{
 		"PromptLocation":        "Lieu",
 		"PromptPlanetPositions": "Positions planétaires",
 		"PromptMajorAspects":    "Aspects majeurs",
-		"PromptElementDist":     "Répartition des éléments",
 		"PromptRetrograde":      " (RÉTROGRADE)",
 		"PromptOrb":             "orbe",
+		"PromptDignities":       "Dignités essentielles (planètes traditionnelles)",
+		"PromptChartRuler":      "Maître du thème",
+		"PromptFinalDispositor": "Dispositeur final",
+		"PromptReceptions":      "Réceptions mutuelles",
+		"PromptPatterns":        "Configurations d'aspects",
+		"PromptFixedStars":      "Contacts d'étoiles fixes",
+		"PromptDeclination":     "déclinaison",
+		"PromptOutOfBounds":     " (HORS LIMITES)",
+		"PromptAboveHorizon":    "Planètes au-dessus de l'horizon à la naissance",
+		"PromptStationary":      " (STATIONNAIRE)",
+		"PromptApplying":        "appliquant",
+		"PromptSeparating":      "séparant",
+		"PromptTimeLords":       "Maîtres du temps actuels",
+		"PromptLordOfYear":      "maître de l'année",
+		"PromptRelocated":       "THÈME RELOCALISÉ : les maisons et les angles sont calculés pour le lieu ci-dessus, pas pour le lieu de naissance",
+		"PromptBirthPlace":      "Lieu de naissance",
+		"PromptHouses":          "Maisons (maison relocalisée / maison natale)",
+		"PromptPlanetaryHours":  "Heures planétaires du jour en cours au lieu du thème",
+		"PromptDayRuler":        "Maître du jour",
+		"PromptCurrentHour":     "Heure en cours",
+		"PromptHourLeft":        "encore %s",
+		"PromptDayHour":         "jour",
+		"PromptNightHour":       "nuit",
+		"PromptOverview":        "Vue d'ensemble (pondérée, luminaires et angles comptent double)",
 
 		// Preflight checks
-		"PreflightOpenAIHelp":  "export OPENAI_API_KEY=\"sk-...\"",
-		"PreflightCityHelp":    "export ASTRAL_CITY=\"Paris, France\"",
-		"PreflightTerminal":    "Terminal (Kitty graphics)",
-		"PreflightTerminalHelp": "Utilisez Kitty, Ghostty ou WezTerm",
-		"PreflightResvgHelp":   "cargo install resvg\nou: brew install resvg",
+		"PreflightOpenAIHelp": "export OPENAI_API_KEY=\"sk-...\"",
+		"PreflightCityHelp":   "export ASTRAL_CITY=\"Paris, France\"",
+		"PreflightResvgHelp":  "cargo install resvg\nou: brew install resvg",
 	},
 
 	ES: {
 		// Form
-		"FormTitle":                "Consultar al Oráculo",
-		"FormBirthDate":            "Fecha de nacimiento",
-		"FormBirthDateDesc":        "Formato: DD/MM/AAAA",
-		"FormBirthDatePlaceholder": "21/03/1990",
-		"FormTransitDate":          "Fecha de tránsito",
-		"FormTransitDateDesc":      "Para predicciones (DD/MM/AAAA)",
+		"FormTitle":                  "Consultar al Oráculo",
+		"FormBirthDate":              "Fecha de nacimiento",
+		"FormBirthDateDesc":          "Formato: DD/MM/AAAA",
+		"FormBirthDatePlaceholder":   "21/03/1990",
+		"FormTransitDate":            "Fecha de tránsito",
+		"FormTransitDateDesc":        "Para predicciones (DD/MM/AAAA)",
 		"FormTransitDatePlaceholder": "01/01/2025",
-		"FormQuestion":             "Hazle tu pregunta al oráculo",
-		"FormQuestionDesc":         "Ej: ¿Debo aceptar este trabajo? ¿Es el momento adecuado para...?",
-		"FormQuestionPlaceholder":  "¿Qué te preocupa?",
+		"FormQuestion":               "Hazle tu pregunta al oráculo",
+		"FormQuestionDesc":           "Ej: ¿Debo aceptar este trabajo? ¿Es el momento adecuado para...?",
+		"FormQuestionPlaceholder":    "¿Qué te preocupa?",
+		"FormRelocation":             "Relocalización (opcional)",
+		"FormRelocationDesc":         "Ciudad para la que calcular las casas, ej. Lisboa, Portugal",
+		"FormRelocationPlaceholder":  "Dejar vacío para el lugar de nacimiento",
+		"FormElection":               "Elección (opcional)",
+		"FormElectionDesc":           "Criterios buscados en los 90 días desde la fecha de tránsito, ej. moon waxing, moon not void, venus trine natal sun",
+		"FormElectionPlaceholder":    "Dejar vacío para no buscar",
+		"FormSex":                    "Sexo (opcional)",
+		"FormSexDesc":                "Fija el sentido de los pilares de suerte BaZi",
+		"FormSexUnknown":             "Sin indicar",
+		"FormSexFemale":              "Mujer",
+		"FormSexMale":                "Hombre",
 
 		// Validation
 		"ValidationRequired":      "fecha requerida",
@@ -218,13 +609,17 @@ var messages = map[Lang]map[string]string{
 		"StatusWaitingData":       "Esperando datos...",
 		"StatusWaitingNatal":      "Esperando carta natal...",
 		"StatusError":             "Error: ",
+		"StatusExported":          "Carta exportada a ",
+		"StatusExportError":       "Error de exportación: ",
 
 		// Missing city
 		"MissingCityError": "Variable ASTRAL_CITY faltante",
 		"MissingCityHint":  "Configure la variable de entorno:",
 
 		// Header
-		"HeaderTitle": "MI ORÁCULO",
+		"HeaderTitle":         "MI ORÁCULO",
+		"HeaderPlanetaryDay":  "día %s",
+		"HeaderPlanetaryHour": "hora %s",
 
 		// Interpretation
 		"InterpTitle":   "Interpretación",
@@ -232,28 +627,182 @@ var messages = map[Lang]map[string]string{
 		"InterpError":   "[Error]",
 
 		// Positions
-		"PositionPlanet":   "Planeta",
-		"PositionNatal":    "Natal",
-		"PositionTransit":  "Tránsito",
-		"PositionPosition": "Posición",
-		"PositionTransits": "Tránsitos",
-		"PositionBoth":     "Natal / Tránsitos",
+		"PositionPlanet":      "Planeta",
+		"PositionNatal":       "Natal",
+		"PositionTransit":     "Tránsito",
+		"PositionPosition":    "Posición",
+		"PositionTransits":    "Tránsitos",
+		"PositionBoth":        "Natal / Tránsitos",
+		"PositionDeclination": "Decl.",
+		"PositionOOB":         "FL",
+		"PositionSpeed":       "Velocidad",
+		"SpeedFast":           "ráp.",
+		"SpeedSlow":           "lent",
+		"SpeedStationary":     "est.",
+
+		// Dignities
+		"DignityTitle":           "Dignidades esenciales",
+		"DignitySign":            "Signo",
+		"DignityScore":           "Puntos",
+		"DignityDomicileShort":   "Dom",
+		"DignityExaltationShort": "Exa",
+		"DignityTriplicityShort": "Tri",
+		"DignityTermShort":       "Tér",
+		"DignityFaceShort":       "Fax",
+		"DignityDetrimentShort":  "Exi",
+		"DignityFallShort":       "Caí",
+		"DignityChartRuler":      "Regente de la carta",
+		"DignityFinalDispositor": "Dispositor final",
+		"DignityReceptions":      "Recepciones mutuas",
+		"DignityDispositors":     "Cadenas de dispositores",
+		"DignityNone":            "ninguno",
+
+		// Patterns
+		"PatternTitle": "Configuraciones",
+		"PatternNone":  "Ninguna configuración mayor en esta carta",
+		"PatternApex":  "ápice",
+		"PatternHouse": "casa",
+		"PatternSpan":  "extensión",
+
+		// Fixed stars
+		"StarTitle":           "Estrellas fijas",
+		"StarNone":            "Ningún contacto de estrella fija dentro del orbe",
+		"StarConjunctions":    "Conjunciones",
+		"StarParans":          "Paranatellonta",
+		"StarRising":          "saliendo",
+		"StarCulminating":     "culminando",
+		"StarSetting":         "poniéndose",
+		"StarAntiCulminating": "anticulminando",
+
+		// Sky
+		"SkyTitle":    "Cielo al nacer",
+		"SkyAltitude": "Alt.",
+		"SkyAzimuth":  "Az.",
+		"SkyRise":     "Salida",
+		"SkyTransit":  "Tránsito",
+		"SkySet":      "Puesta",
+
+		// Heliocentric
+		"HelioTitle":        "Geocéntrico / Heliocéntrico",
+		"HelioGeocentric":   "Geocéntrico",
+		"HelioHeliocentric": "Heliocéntrico",
+
+		// Timeline
+		"TimelineTitle":       "Señores del tiempo",
+		"TimelineProfections": "Profecciones",
+		"TimelineYear":        "Año",
+		"TimelineMonth":       "Mes",
+		"TimelineAge":         "edad",
+		"TimelineHouse":       "casa",
+		"TimelineFirdaria":    "Firdaria",
+		"TimelineReleasing":   "Liberación zodiacal",
+		"TimelineFortune":     "Fortuna",
+		"TimelineSpirit":      "Espíritu",
+		"TimelinePeak":        "pico",
+		"TimelineLoosing":     "liberación del vínculo",
+		"TimelineUntil":       "hasta",
+
+		// Relocation
+		"RelocationTitle":     "Casas natales / relocalizadas",
+		"RelocationNone":      "Sin relocalización: indica una ciudad en el campo Relocalización del formulario",
+		"RelocationNatal":     "Natal",
+		"RelocationRelocated": "Relocalizado",
+
+		// Election
+		"ElectionTitle":     "Elección",
+		"ElectionNone":      "Sin elección: indica criterios en el campo Elección del formulario",
+		"ElectionSearching": "Buscando en las efemérides...",
+		"ElectionNoWindow":  "Ninguna ventana cumple todos los criterios",
+		"ElectionError":     "Error de búsqueda: ",
+		"ElectionStart":     "Inicio",
+		"ElectionEnd":       "Fin",
+		"ElectionPeak":      "Pico",
+		"ElectionScore":     "Puntuación",
+
+		// Overview
+		"OverviewTitle":       "Visión general de la carta",
+		"OverviewBalance":     "Equilibrio ponderado (luminarias y ángulos cuentan doble)",
+		"OverviewElements":    "Elementos",
+		"OverviewModalities":  "Modalidades",
+		"OverviewDominant":    "dominante",
+		"OverviewHemispheres": "Hemisferios",
+		"OverviewEastern":     "Este",
+		"OverviewWestern":     "Oeste",
+		"OverviewNorthern":    "Norte (bajo el horizonte)",
+		"OverviewSouthern":    "Sur (sobre el horizonte)",
+		"OverviewQuadrants":   "Cuadrantes",
+		"OverviewNoHouses":    "Sin casas: hemisferios desconocidos",
+		"OverviewShape":       "Forma",
+		"OverviewLeader":      "planeta guía",
+		"OverviewHandle":      "asa",
+		"ShapeBundle":         "Haz",
+		"ShapeBundleDesc":     "Todos los planetas en un trígono: una vida concentrada y especializada.",
+		"ShapeBowl":           "Cuenco",
+		"ShapeBowlDesc":       "Todos los planetas en media rueda: autosuficiente, impulsado a llenar la mitad vacía.",
+		"ShapeBucket":         "Cubo",
+		"ShapeBucketDesc":     "Un cuenco con un planeta aislado enfrente, el asa, que canaliza toda la energía.",
+		"ShapeLocomotive":     "Locomotora",
+		"ShapeLocomotiveDesc": "Planetas en dos tercios de la rueda, un trígono vacío: impulso propio.",
+		"ShapeSeesaw":         "Balancín",
+		"ShapeSeesawDesc":     "Dos grupos opuestos: sopesar opciones, equilibrar fuerzas contrarias.",
+		"ShapeSplay":          "Abanico",
+		"ShapeSplayDesc":      "Grupos irregulares: individualista, se resiste a ser encasillado.",
+		"ShapeSplash":         "Salpicadura",
+		"ShapeSplashDesc":     "Planetas repartidos por la rueda: intereses amplios y dispersos.",
+
+		// BaZi
+		"BaZiTitle":         "Cuatro Pilares (BaZi)",
+		"BaZiYear":          "Año",
+		"BaZiMonth":         "Mes",
+		"BaZiDay":           "Día",
+		"BaZiHour":          "Hora",
+		"BaZiStem":          "Tronco",
+		"BaZiBranch":        "Rama",
+		"BaZiDayMaster":     "Maestro del día",
+		"BaZiSolarTime":     "Hora solar",
+		"BaZiLuck":          "Pilares de suerte",
+		"BaZiForward":       "hacia adelante",
+		"BaZiBackward":      "hacia atrás",
+		"BaZiAge":           "Edad",
+		"BaZiPeriod":        "Periodo",
+		"BaZiNoSex":         "Elija un sexo en el formulario para ver los pilares de suerte.",
+		"BaZiNoTime":        "El formulario no da hora de nacimiento: el pilar de la hora sigue la hora actual.",
+		"BaZiAnimalRat":     "Rata",
+		"BaZiAnimalOx":      "Buey",
+		"BaZiAnimalTiger":   "Tigre",
+		"BaZiAnimalRabbit":  "Conejo",
+		"BaZiAnimalDragon":  "Dragón",
+		"BaZiAnimalSnake":   "Serpiente",
+		"BaZiAnimalHorse":   "Caballo",
+		"BaZiAnimalGoat":    "Cabra",
+		"BaZiAnimalMonkey":  "Mono",
+		"BaZiAnimalRooster": "Gallo",
+		"BaZiAnimalDog":     "Perro",
+		"BaZiAnimalPig":     "Cerdo",
+		"BaZiElementWood":   "Madera",
+		"BaZiElementFire":   "Fuego",
+		"BaZiElementEarth":  "Tierra",
+		"BaZiElementMetal":  "Metal",
+		"BaZiElementWater":  "Agua",
 
 		// Wheel
-		"WheelPlaceholder": "[ Rueda zodiacal ]\n(Kitty/resvg requerido)",
 
 		// Navigation
 		"NavNavigate":    " navegar",
 		"NavScroll":      " desplazar",
 		"NavNewQuestion": " nueva pregunta",
 		"NavQuit":        " salir",
+		"NavPanels":      " paneles",
+		"NavExport":      " exportar",
 
 		// Elements
-		"ElementFire":  "Fuego",
-		"ElementEarth": "Tierra",
-		"ElementAir":   "Aire",
-		"ElementWater": "Agua",
-		"ElementCount": "%d planetas",
+		"ElementFire":      "Fuego",
+		"ElementEarth":     "Tierra",
+		"ElementAir":       "Aire",
+		"ElementWater":     "Agua",
+		"ModalityCardinal": "Cardinal",
+		"ModalityFixed":    "Fijo",
+		"ModalityMutable":  "Mutable",
 
 		// Weekdays
 		"WeekdaySunday":    "Domingo",
@@ -274,30 +823,62 @@ var messages = map[Lang]map[string]string{
 		"PromptLocation":        "Lugar",
 		"PromptPlanetPositions": "Posiciones planetarias",
 		"PromptMajorAspects":    "Aspectos mayores",
-		"PromptElementDist":     "Distribución de elementos",
 		"PromptRetrograde":      " (RETRÓGRADO)",
 		"PromptOrb":             "orbe",
+		"PromptDignities":       "Dignidades esenciales (planetas tradicionales)",
+		"PromptChartRuler":      "Regente de la carta",
+		"PromptFinalDispositor": "Dispositor final",
+		"PromptReceptions":      "Recepciones mutuas",
+		"PromptPatterns":        "Configuraciones de aspectos",
+		"PromptFixedStars":      "Contactos de estrellas fijas",
+		"PromptDeclination":     "declinación",
+		"PromptOutOfBounds":     " (FUERA DE LÍMITES)",
+		"PromptAboveHorizon":    "Planetas sobre el horizonte al nacer",
+		"PromptStationary":      " (ESTACIONARIO)",
+		"PromptApplying":        "aplicativo",
+		"PromptSeparating":      "separativo",
+		"PromptTimeLords":       "Señores del tiempo actuales",
+		"PromptLordOfYear":      "señor del año",
+		"PromptRelocated":       "CARTA RELOCALIZADA: las casas y los ángulos se calculan para el lugar indicado arriba, no para el lugar de nacimiento",
+		"PromptBirthPlace":      "Lugar de nacimiento",
+		"PromptHouses":          "Casas (casa relocalizada / casa natal)",
+		"PromptPlanetaryHours":  "Horas planetarias del día en curso en el lugar de la carta",
+		"PromptDayRuler":        "Regente del día",
+		"PromptCurrentHour":     "Hora actual",
+		"PromptHourLeft":        "quedan %s",
+		"PromptDayHour":         "día",
+		"PromptNightHour":       "noche",
+		"PromptOverview":        "Visión general (ponderada, luminarias y ángulos cuentan doble)",
 
 		// Preflight checks
-		"PreflightOpenAIHelp":  "export OPENAI_API_KEY=\"sk-...\"",
-		"PreflightCityHelp":    "export ASTRAL_CITY=\"Paris, France\"",
-		"PreflightTerminal":    "Terminal (Kitty graphics)",
-		"PreflightTerminalHelp": "Use Kitty, Ghostty o WezTerm",
-		"PreflightResvgHelp":   "cargo install resvg\no: brew install resvg",
+		"PreflightOpenAIHelp": "export OPENAI_API_KEY=\"sk-...\"",
+		"PreflightCityHelp":   "export ASTRAL_CITY=\"Paris, France\"",
+		"PreflightResvgHelp":  "cargo install resvg\no: brew install resvg",
 	},
 
 	DE: {
 		// Form
-		"FormTitle":                "Das Orakel befragen",
-		"FormBirthDate":            "Geburtsdatum",
-		"FormBirthDateDesc":        "Format: TT/MM/JJJJ",
-		"FormBirthDatePlaceholder": "21/03/1990",
-		"FormTransitDate":          "Transitdatum",
-		"FormTransitDateDesc":      "Für Vorhersagen (TT/MM/JJJJ)",
+		"FormTitle":                  "Das Orakel befragen",
+		"FormBirthDate":              "Geburtsdatum",
+		"FormBirthDateDesc":          "Format: TT/MM/JJJJ",
+		"FormBirthDatePlaceholder":   "21/03/1990",
+		"FormTransitDate":            "Transitdatum",
+		"FormTransitDateDesc":        "Für Vorhersagen (TT/MM/JJJJ)",
 		"FormTransitDatePlaceholder": "01/01/2025",
-		"FormQuestion":             "Stelle dem Orakel deine Frage",
-		"FormQuestionDesc":         "Z.B.: Soll ich diesen Job annehmen? Ist es der richtige Zeitpunkt für...?",
-		"FormQuestionPlaceholder":  "Was beschäftigt dich?",
+		"FormQuestion":               "Stelle dem Orakel deine Frage",
+		"FormQuestionDesc":           "Z.B.: Soll ich diesen Job annehmen? Ist es der richtige Zeitpunkt für...?",
+		"FormQuestionPlaceholder":    "Was beschäftigt dich?",
+		"FormRelocation":             "Relokation (optional)",
+		"FormRelocationDesc":         "Stadt, für die die Häuser berechnet werden, z. B. Lissabon, Portugal",
+		"FormRelocationPlaceholder":  "Leer lassen für den Geburtsort",
+		"FormElection":               "Elektion (optional)",
+		"FormElectionDesc":           "Kriterien, gesucht in den 90 Tagen ab dem Transitdatum, z. B. moon waxing, moon not void, venus trine natal sun",
+		"FormElectionPlaceholder":    "Leer lassen, um nicht zu suchen",
+		"FormSex":                    "Geschlecht (optional)",
+		"FormSexDesc":                "Bestimmt die Richtung der BaZi-Glückssäulen",
+		"FormSexUnknown":             "Keine Angabe",
+		"FormSexFemale":              "Frau",
+		"FormSexMale":                "Mann",
 
 		// Validation
 		"ValidationRequired":      "Datum erforderlich",
@@ -313,13 +894,17 @@ var messages = map[Lang]map[string]string{
 		"StatusWaitingData":       "Warte auf Daten...",
 		"StatusWaitingNatal":      "Warte auf Geburtshoroskop...",
 		"StatusError":             "Fehler: ",
+		"StatusExported":          "Horoskop exportiert nach ",
+		"StatusExportError":       "Exportfehler: ",
 
 		// Missing city
 		"MissingCityError": "Variable ASTRAL_CITY fehlt",
 		"MissingCityHint":  "Setzen Sie die Umgebungsvariable:",
 
 		// Header
-		"HeaderTitle": "MEIN ORAKEL",
+		"HeaderTitle":         "MEIN ORAKEL",
+		"HeaderPlanetaryDay":  "Tag %s",
+		"HeaderPlanetaryHour": "Stunde %s",
 
 		// Interpretation
 		"InterpTitle":   "Deutung",
@@ -327,28 +912,182 @@ var messages = map[Lang]map[string]string{
 		"InterpError":   "[Fehler]",
 
 		// Positions
-		"PositionPlanet":   "Planet",
-		"PositionNatal":    "Natal",
-		"PositionTransit":  "Transit",
-		"PositionPosition": "Position",
-		"PositionTransits": "Transite",
-		"PositionBoth":     "Natal / Transite",
+		"PositionPlanet":      "Planet",
+		"PositionNatal":       "Natal",
+		"PositionTransit":     "Transit",
+		"PositionPosition":    "Position",
+		"PositionTransits":    "Transite",
+		"PositionBoth":        "Natal / Transite",
+		"PositionDeclination": "Dekl.",
+		"PositionOOB":         "OOB",
+		"PositionSpeed":       "Tempo",
+		"SpeedFast":           "schn",
+		"SpeedSlow":           "lang",
+		"SpeedStationary":     "stat",
+
+		// Dignities
+		"DignityTitle":           "Essentielle Würden",
+		"DignitySign":            "Zeichen",
+		"DignityScore":           "Punkte",
+		"DignityDomicileShort":   "Dom",
+		"DignityExaltationShort": "Erh",
+		"DignityTriplicityShort": "Tri",
+		"DignityTermShort":       "Ter",
+		"DignityFaceShort":       "Dek",
+		"DignityDetrimentShort":  "Exi",
+		"DignityFallShort":       "Fal",
+		"DignityChartRuler":      "Herrscher des Horoskops",
+		"DignityFinalDispositor": "Finaler Dispositor",
+		"DignityReceptions":      "Gegenseitige Rezeptionen",
+		"DignityDispositors":     "Dispositorketten",
+		"DignityNone":            "keiner",
+
+		// Patterns
+		"PatternTitle": "Aspektfiguren",
+		"PatternNone":  "Keine größere Aspektfigur in diesem Horoskop",
+		"PatternApex":  "Spitze",
+		"PatternHouse": "Haus",
+		"PatternSpan":  "Spanne",
+
+		// Fixed stars
+		"StarTitle":           "Fixsterne",
+		"StarNone":            "Kein Fixsternkontakt im Orbis",
+		"StarConjunctions":    "Konjunktionen",
+		"StarParans":          "Parane",
+		"StarRising":          "aufgehend",
+		"StarCulminating":     "kulminierend",
+		"StarSetting":         "untergehend",
+		"StarAntiCulminating": "unterkulminierend",
+
+		// Sky
+		"SkyTitle":    "Himmel bei Geburt",
+		"SkyAltitude": "Höhe",
+		"SkyAzimuth":  "Az.",
+		"SkyRise":     "Aufgang",
+		"SkyTransit":  "Kulmin.",
+		"SkySet":      "Untergang",
+
+		// Heliocentric
+		"HelioTitle":        "Geozentrisch / Heliozentrisch",
+		"HelioGeocentric":   "Geozentrisch",
+		"HelioHeliocentric": "Heliozentrisch",
+
+		// Timeline
+		"TimelineTitle":       "Zeitherrscher",
+		"TimelineProfections": "Profektionen",
+		"TimelineYear":        "Jahr",
+		"TimelineMonth":       "Monat",
+		"TimelineAge":         "Alter",
+		"TimelineHouse":       "Haus",
+		"TimelineFirdaria":    "Firdaria",
+		"TimelineReleasing":   "Zodiakale Freisetzung",
+		"TimelineFortune":     "Glück",
+		"TimelineSpirit":      "Geist",
+		"TimelinePeak":        "Höhepunkt",
+		"TimelineLoosing":     "Lösung des Bandes",
+		"TimelineUntil":       "bis",
+
+		// Relocation
+		"RelocationTitle":     "Geburts- / Relokationshäuser",
+		"RelocationNone":      "Keine Relokation: Stadt im Feld Relokation des Formulars eingeben",
+		"RelocationNatal":     "Geburt",
+		"RelocationRelocated": "Relokation",
+
+		// Election
+		"ElectionTitle":     "Elektion",
+		"ElectionNone":      "Keine Elektion: Kriterien im Feld Elektion des Formulars eingeben",
+		"ElectionSearching": "Ephemeride wird durchsucht...",
+		"ElectionNoWindow":  "Kein Zeitfenster erfüllt alle Kriterien",
+		"ElectionError":     "Suchfehler: ",
+		"ElectionStart":     "Beginn",
+		"ElectionEnd":       "Ende",
+		"ElectionPeak":      "Höhepunkt",
+		"ElectionScore":     "Wertung",
+
+		// Overview
+		"OverviewTitle":       "Horoskopüberblick",
+		"OverviewBalance":     "Gewichtete Verteilung (Lichter und Achsen zählen doppelt)",
+		"OverviewElements":    "Elemente",
+		"OverviewModalities":  "Qualitäten",
+		"OverviewDominant":    "dominant",
+		"OverviewHemispheres": "Hemisphären",
+		"OverviewEastern":     "Osten",
+		"OverviewWestern":     "Westen",
+		"OverviewNorthern":    "Norden (unter dem Horizont)",
+		"OverviewSouthern":    "Süden (über dem Horizont)",
+		"OverviewQuadrants":   "Quadranten",
+		"OverviewNoHouses":    "Keine Häuser: Hemisphären unbekannt",
+		"OverviewShape":       "Form",
+		"OverviewLeader":      "führender Planet",
+		"OverviewHandle":      "Henkel",
+		"ShapeBundle":         "Bündel",
+		"ShapeBundleDesc":     "Alle Planeten in einem Trigon: ein konzentriertes, spezialisiertes Leben.",
+		"ShapeBowl":           "Schale",
+		"ShapeBowlDesc":       "Alle Planeten in einer Hälfte des Rades: in sich geschlossen, bestrebt, die leere Hälfte zu füllen.",
+		"ShapeBucket":         "Eimer",
+		"ShapeBucketDesc":     "Eine Schale mit einem einzelnen Planeten gegenüber, dem Henkel, der alle Energie bündelt.",
+		"ShapeLocomotive":     "Lokomotive",
+		"ShapeLocomotiveDesc": "Planeten über zwei Drittel des Rades, ein leeres Trigon: eigener Antrieb.",
+		"ShapeSeesaw":         "Wippe",
+		"ShapeSeesawDesc":     "Zwei gegenüberliegende Gruppen: Abwägen, Ausgleich gegensätzlicher Kräfte.",
+		"ShapeSplay":          "Fächer",
+		"ShapeSplayDesc":      "Unregelmäßige Gruppen: individualistisch, lässt sich nicht einordnen.",
+		"ShapeSplash":         "Spritzer",
+		"ShapeSplashDesc":     "Planeten über das ganze Rad verteilt: vielseitige, gestreute Interessen.",
+
+		// BaZi
+		"BaZiTitle":         "Vier Säulen (BaZi)",
+		"BaZiYear":          "Jahr",
+		"BaZiMonth":         "Monat",
+		"BaZiDay":           "Tag",
+		"BaZiHour":          "Stunde",
+		"BaZiStem":          "Stamm",
+		"BaZiBranch":        "Zweig",
+		"BaZiDayMaster":     "Tagesmeister",
+		"BaZiSolarTime":     "Sonnenzeit",
+		"BaZiLuck":          "Glückssäulen",
+		"BaZiForward":       "vorwärts",
+		"BaZiBackward":      "rückwärts",
+		"BaZiAge":           "Alter",
+		"BaZiPeriod":        "Zeitraum",
+		"BaZiNoSex":         "Wählen Sie im Formular ein Geschlecht, um die Glückssäulen zu sehen.",
+		"BaZiNoTime":        "Das Formular enthält keine Geburtszeit: die Stundensäule folgt der aktuellen Uhrzeit.",
+		"BaZiAnimalRat":     "Ratte",
+		"BaZiAnimalOx":      "Büffel",
+		"BaZiAnimalTiger":   "Tiger",
+		"BaZiAnimalRabbit":  "Hase",
+		"BaZiAnimalDragon":  "Drache",
+		"BaZiAnimalSnake":   "Schlange",
+		"BaZiAnimalHorse":   "Pferd",
+		"BaZiAnimalGoat":    "Ziege",
+		"BaZiAnimalMonkey":  "Affe",
+		"BaZiAnimalRooster": "Hahn",
+		"BaZiAnimalDog":     "Hund",
+		"BaZiAnimalPig":     "Schwein",
+		"BaZiElementWood":   "Holz",
+		"BaZiElementFire":   "Feuer",
+		"BaZiElementEarth":  "Erde",
+		"BaZiElementMetal":  "Metall",
+		"BaZiElementWater":  "Wasser",
 
 		// Wheel
-		"WheelPlaceholder": "[ Tierkreisrad ]\n(Kitty/resvg erforderlich)",
 
 		// Navigation
 		"NavNavigate":    " navigieren",
 		"NavScroll":      " scrollen",
 		"NavNewQuestion": " neue Frage",
 		"NavQuit":        " beenden",
+		"NavPanels":      " Ansichten",
+		"NavExport":      " exportieren",
 
 		// Elements
-		"ElementFire":  "Feuer",
-		"ElementEarth": "Erde",
-		"ElementAir":   "Luft",
-		"ElementWater": "Wasser",
-		"ElementCount": "%d Planeten",
+		"ElementFire":      "Feuer",
+		"ElementEarth":     "Erde",
+		"ElementAir":       "Luft",
+		"ElementWater":     "Wasser",
+		"ModalityCardinal": "Kardinal",
+		"ModalityFixed":    "Fix",
+		"ModalityMutable":  "Veränderlich",
 
 		// Weekdays
 		"WeekdaySunday":    "Sonntag",
@@ -369,15 +1108,36 @@ var messages = map[Lang]map[string]string{
 		"PromptLocation":        "Ort",
 		"PromptPlanetPositions": "Planetenpositionen",
 		"PromptMajorAspects":    "Hauptaspekte",
-		"PromptElementDist":     "Elementverteilung",
 		"PromptRetrograde":      " (RÜCKLÄUFIG)",
 		"PromptOrb":             "Orbis",
+		"PromptDignities":       "Essentielle Würden (klassische Planeten)",
+		"PromptChartRuler":      "Herrscher des Horoskops",
+		"PromptFinalDispositor": "Finaler Dispositor",
+		"PromptReceptions":      "Gegenseitige Rezeptionen",
+		"PromptPatterns":        "Aspektfiguren",
+		"PromptFixedStars":      "Fixsternkontakte",
+		"PromptDeclination":     "Deklination",
+		"PromptOutOfBounds":     " (AUSSERHALB DER GRENZEN)",
+		"PromptAboveHorizon":    "Planeten über dem Horizont bei der Geburt",
+		"PromptStationary":      " (STATIONÄR)",
+		"PromptApplying":        "applikativ",
+		"PromptSeparating":      "separativ",
+		"PromptTimeLords":       "Aktuelle Zeitherrscher",
+		"PromptLordOfYear":      "Jahresherrscher",
+		"PromptRelocated":       "RELOKATIONSHOROSKOP: Häuser und Achsen sind für den obigen Ort berechnet, nicht für den Geburtsort",
+		"PromptBirthPlace":      "Geburtsort",
+		"PromptHouses":          "Hausstellungen (Relokationshaus / Geburtshaus)",
+		"PromptPlanetaryHours":  "Planetenstunden des laufenden Tages am Ort des Horoskops",
+		"PromptDayRuler":        "Tagesherrscher",
+		"PromptCurrentHour":     "Aktuelle Stunde",
+		"PromptHourLeft":        "noch %s",
+		"PromptDayHour":         "Tag",
+		"PromptNightHour":       "Nacht",
+		"PromptOverview":        "Überblick (gewichtet, Lichter und Achsen zählen doppelt)",
 
 		// Preflight checks
-		"PreflightOpenAIHelp":  "export OPENAI_API_KEY=\"sk-...\"",
-		"PreflightCityHelp":    "export ASTRAL_CITY=\"Paris, France\"",
-		"PreflightTerminal":    "Terminal (Kitty graphics)",
-		"PreflightTerminalHelp": "Verwenden Sie Kitty, Ghostty oder WezTerm",
-		"PreflightResvgHelp":   "cargo install resvg\noder: brew install resvg",
+		"PreflightOpenAIHelp": "export OPENAI_API_KEY=\"sk-...\"",
+		"PreflightCityHelp":   "export ASTRAL_CITY=\"Paris, France\"",
+		"PreflightResvgHelp":  "cargo install resvg\noder: brew install resvg",
 	},
 }
@@ -4,10 +4,10 @@ package preflight
 import (
 	"fmt"
 	"os"
-	"os/exec"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/render"
 )
 
 // CheckResult represents the result of a single dependency check.
@@ -19,12 +19,15 @@ type CheckResult struct {
 
 // RunChecks verifies all required dependencies and returns the results.
 func RunChecks() []CheckResult {
-	return []CheckResult{
+	results := []CheckResult{
 		checkOpenAIKey(),
 		checkCity(),
-		checkTerminal(),
-		checkResvg(),
 	}
+	// The wheel is rasterized in process unless resvg is asked for
+	if render.SelectedRenderer() == render.Resvg {
+		results = append(results, checkResvg())
+	}
+	return results
 }
 
 func checkOpenAIKey() CheckResult {
@@ -45,21 +48,10 @@ func checkCity() CheckResult {
 	}
 }
 
-func checkTerminal() CheckResult {
-	term := os.Getenv("TERM")
-	ok := term == "xterm-kitty" || term == "xterm-ghostty" || term == "wezterm"
-	return CheckResult{
-		Name: i18n.T("PreflightTerminal"),
-		OK:   ok,
-		Help: i18n.T("PreflightTerminalHelp"),
-	}
-}
-
 func checkResvg() CheckResult {
-	_, err := exec.LookPath("resvg")
 	return CheckResult{
 		Name: "resvg",
-		OK:   err == nil,
+		OK:   render.HasResvg(),
 		Help: i18n.T("PreflightResvgHelp"),
 	}
 }
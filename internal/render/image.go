@@ -2,31 +2,81 @@
 package render
 
 import (
+	"bytes"
 	"fmt"
+	"image/png"
 	"os"
 	"os/exec"
 	"path/filepath"
 )
 
-// SVGToPNG converts SVG data to PNG using resvg CLI
+// Renderer is a backend converting SVG to PNG
+type Renderer int
+
+// Renderers.
+const (
+	Builtin Renderer = iota // In-process rasterizer
+	Resvg                   // External resvg binary
+)
+
+// String returns the name of the renderer
+func (r Renderer) String() string {
+	if r == Resvg {
+		return "resvg"
+	}
+	return "builtin"
+}
+
+// RendererEnv is the environment variable selecting the renderer; set it
+// to "resvg" to use the resvg binary instead of the built-in rasterizer.
+const RendererEnv = "ASTRAL_RENDERER"
+
+// SelectedRenderer returns the renderer chosen in ASTRAL_RENDERER
+func SelectedRenderer() Renderer {
+	if os.Getenv(RendererEnv) == "resvg" {
+		return Resvg
+	}
+	return Builtin
+}
+
+// SVGToPNG converts SVG data to PNG with the selected renderer
 func SVGToPNG(svgData []byte, width, height int) ([]byte, error) {
+	if SelectedRenderer() == Resvg {
+		return resvgToPNG(svgData, width, height)
+	}
+	img, err := RasterizeSVG(svgData, width, height)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// resvgToPNG converts SVG data to PNG using resvg CLI
+func resvgToPNG(svgData []byte, width, height int) ([]byte, error) {
 	// Check if resvg is available
 	resvgPath, err := exec.LookPath("resvg")
 	if err != nil {
-		return nil, fmt.Errorf("resvg not found: install with 'brew install resvg' or 'cargo install resvg'")
+		return nil, fmt.Errorf("resvg not found: install with 'brew install resvg' or 'cargo install resvg', or unset %s", RendererEnv)
 	}
 
-	// Create temp files
-	tmpDir := os.TempDir()
-	svgPath := filepath.Join(tmpDir, "horoscope_wheel.svg")
-	pngPath := filepath.Join(tmpDir, "horoscope_wheel.png")
+	// Each conversion works in its own directory, so that concurrent
+	// renderings do not overwrite each other's files
+	tmpDir, err := os.MkdirTemp("", "astral-svg-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+	svgPath := filepath.Join(tmpDir, "image.svg")
+	pngPath := filepath.Join(tmpDir, "image.png")
 
 	// Write SVG to temp file
-	if err := os.WriteFile(svgPath, svgData, 0644); err != nil {
+	if err := os.WriteFile(svgPath, svgData, 0600); err != nil {
 		return nil, fmt.Errorf("failed to write SVG: %w", err)
 	}
-	defer func() { _ = os.Remove(svgPath) }()
-	defer func() { _ = os.Remove(pngPath) }()
 
 	// Run resvg
 	args := []string{
@@ -42,13 +92,11 @@ func SVGToPNG(svgData []byte, width, height int) ([]byte, error) {
 		return nil, fmt.Errorf("resvg failed: %w\noutput: %s", err, output)
 	}
 
-	// Check if PNG was created
-	if _, err := os.Stat(pngPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("resvg did not create output file, output: %s", output)
-	}
-
 	// Read PNG data
 	pngData, err := os.ReadFile(pngPath)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("resvg did not create output file, output: %s", output)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to read PNG: %w", err)
 	}
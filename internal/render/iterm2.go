@@ -0,0 +1,39 @@
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"io"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/ansi/iterm2"
+)
+
+// iterm2Output displays images with the inline images protocol of iTerm2
+// (OSC 1337), also understood by WezTerm
+type iterm2Output struct {
+	cell cellSize
+}
+
+// Place sends each row of the image as a PNG stretched over one row of
+// cells
+func (o iterm2Output) Place(_ io.Writer, img image.Image, cols, rows int) ([]string, error) {
+	fitted := fitImage(img, cols, rows, o.cell)
+	return stripRows(fitted, cols, rows, o.cell, func(strip *image.RGBA) (string, error) {
+		var data bytes.Buffer
+		if err := png.Encode(&data, strip); err != nil {
+			return "", err
+		}
+		return ansi.ITerm2(iterm2.File{
+			Size:              int64(data.Len()),
+			Width:             iterm2.Cells(cols),
+			Height:            iterm2.Cells(1),
+			IgnoreAspectRatio: true,
+			Inline:            true,
+			DoNotMoveCursor:   true,
+			Content:           []byte(base64.StdEncoding.EncodeToString(data.Bytes())),
+		}), nil
+	})
+}
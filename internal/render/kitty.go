@@ -112,7 +112,11 @@ func TransmitAndCreatePlacement(w io.Writer, pngData []byte, imageID, cols, rows
 	if err != nil {
 		return fmt.Errorf("failed to decode PNG: %w", err)
 	}
+	return transmitAndPlace(w, img, imageID, cols, rows)
+}
 
+// transmitAndPlace transmits a decoded image and creates its virtual placement
+func transmitAndPlace(w io.Writer, img image.Image, imageID, cols, rows int) error {
 	// First transmit the image
 	transmitOpts := &kitty.Options{
 		Action:       kitty.Transmit,
@@ -144,6 +148,23 @@ func TransmitAndCreatePlacement(w io.Writer, pngData []byte, imageID, cols, rows
 	return nil
 }
 
+// kittyOutput displays images with the Kitty graphics protocol: the image
+// is stored in the terminal, which draws it in place of Unicode
+// placeholders
+type kittyOutput struct {
+	id int
+}
+
+// Place replaces the stored image and returns the placeholder rows
+func (k kittyOutput) Place(w io.Writer, img image.Image, cols, rows int) ([]string, error) {
+	DeleteImage(w, k.id)
+	if err := transmitAndPlace(w, img, k.id, cols, rows); err != nil {
+		return nil, err
+	}
+	grid := BuildPlaceholderGrid(k.id, cols, rows)
+	return strings.Split(strings.TrimSuffix(grid, "\n"), "\n"), nil
+}
+
 // DeleteImage deletes an image by ID from the terminal
 func DeleteImage(w io.Writer, imageID int) {
 	opts := &kitty.Options{
@@ -160,25 +181,6 @@ func PNGToImage(pngData []byte) (image.Image, error) {
 	return png.Decode(bytes.NewReader(pngData))
 }
 
-// SupportsKittyGraphics checks if the terminal supports Kitty graphics protocol
-func SupportsKittyGraphics() bool {
-	if os.Getenv("KITTY_WINDOW_ID") != "" {
-		return true
-	}
-
-	term := os.Getenv("TERM")
-	if term == "xterm-kitty" || term == "xterm-ghostty" {
-		return true
-	}
-
-	termProgram := os.Getenv("TERM_PROGRAM")
-	if termProgram == "kitty" || termProgram == "ghostty" || termProgram == "WezTerm" {
-		return true
-	}
-
-	return false
-}
-
 // ClearImage clears any displayed images
 func (k *KittyGraphics) ClearImage() {
 	// a=d: delete
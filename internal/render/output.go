@@ -0,0 +1,154 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"golang.org/x/image/draw"
+)
+
+// ImageProtocol is a terminal graphics protocol
+type ImageProtocol int
+
+// Image protocols, from the most to the least preferred.
+const (
+	NoImages ImageProtocol = iota
+	Kitty                  // Kitty graphics with Unicode placeholders
+	ITerm2                 // iTerm2 inline images (OSC 1337)
+	Sixel                  // DEC sixel graphics
+)
+
+var protocolNames = map[ImageProtocol]string{
+	NoImages: "none",
+	Kitty:    "kitty",
+	ITerm2:   "iterm2",
+	Sixel:    "sixel",
+}
+
+// String returns the name of the protocol
+func (p ImageProtocol) String() string {
+	return protocolNames[p]
+}
+
+// GraphicsEnv is the environment variable forcing a protocol: kitty,
+// iterm2, sixel or none
+const GraphicsEnv = "ASTRAL_GRAPHICS"
+
+// Protocol returns the graphics protocol to use: the one set in
+// ASTRAL_GRAPHICS, or else the best one the terminal supports
+func (c TerminalCapabilities) Protocol() ImageProtocol {
+	forced := strings.ToLower(os.Getenv(GraphicsEnv))
+	for p, name := range protocolNames {
+		if forced == name {
+			return p
+		}
+	}
+	switch {
+	case c.KittyGraphics:
+		return Kitty
+	case c.ITerm2:
+		return ITerm2
+	case c.Sixel:
+		return Sixel
+	}
+	return NoImages
+}
+
+// ImageOutput displays images in the middle of the text of an interface.
+// The image is drawn by the rows Place returns, which each draw their own
+// part of it, so that redrawing some lines of the screen redraws the part
+// of the image they hold.
+type ImageOutput interface {
+	// Place prepares an image to fill a block of cols×rows cells, keeping
+	// its aspect ratio, and returns the rows drawing it. It may write to w
+	// to send the image to the terminal beforehand.
+	Place(w io.Writer, img image.Image, cols, rows int) ([]string, error)
+}
+
+// NewImageOutput returns the output of a protocol, or nil for NoImages.
+// Kitty images are stored in the terminal under imageID.
+func NewImageOutput(p ImageProtocol, caps TerminalCapabilities, imageID int) ImageOutput {
+	cell := cellSize{width: caps.CellWidth, height: caps.CellHeight}
+	if cell.width <= 0 || cell.height <= 0 {
+		cell = defaultCellSize
+	}
+	switch p {
+	case Kitty:
+		return kittyOutput{id: imageID}
+	case ITerm2:
+		return iterm2Output{cell: cell}
+	case Sixel:
+		bg := caps.Background
+		if bg == nil {
+			bg = color.Black
+		}
+		return sixelOutput{cell: cell, background: bg}
+	}
+	return nil
+}
+
+// cellSize is the size of a terminal cell in pixels
+type cellSize struct {
+	width, height int
+}
+
+// defaultCellSize is assumed when the terminal does not report it
+var defaultCellSize = cellSize{width: 10, height: 20}
+
+// fitImage scales an image to fit a block of cols×rows cells, keeping its
+// aspect ratio, and centres it on a transparent background
+func fitImage(img image.Image, cols, rows int, cell cellSize) *image.RGBA {
+	w, h := cols*cell.width, rows*cell.height
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	src := img.Bounds()
+	if src.Empty() {
+		return dst
+	}
+	scale := min(float64(w)/float64(src.Dx()), float64(h)/float64(src.Dy()))
+	fw, fh := int(float64(src.Dx())*scale), int(float64(src.Dy())*scale)
+	target := image.Rect((w-fw)/2, (h-fh)/2, (w-fw)/2+fw, (h-fh)/2+fh)
+	draw.CatmullRom.Scale(dst, target, img, src, draw.Src, nil)
+	return dst
+}
+
+// stripRows cuts a fitted image into one strip per row of cells. Each row
+// writes blanks over its cells, then goes back and draws its strip over
+// them, restoring the cursor afterwards. Fully transparent strips are
+// left blank.
+func stripRows(img *image.RGBA, cols, rows int, cell cellSize, encode func(strip *image.RGBA) (string, error)) ([]string, error) {
+	blank := strings.Repeat(" ", cols)
+	lines := make([]string, rows)
+	for row := range lines {
+		bounds := image.Rect(0, row*cell.height, img.Bounds().Dx(), (row+1)*cell.height)
+		if transparent(img, bounds) {
+			lines[row] = blank
+			continue
+		}
+		// Strips start at the origin, which encoders expect
+		strip := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(strip, strip.Bounds(), img, bounds.Min, draw.Src)
+		seq, err := encode(strip)
+		if err != nil {
+			return nil, err
+		}
+		lines[row] = blank + ansi.SaveCursor + ansi.CursorBackward(cols) + seq + ansi.RestoreCursor
+	}
+	return lines, nil
+}
+
+// transparent reports whether an area of an image is fully transparent
+func transparent(img *image.RGBA, r image.Rectangle) bool {
+	r = r.Intersect(img.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if img.RGBAAt(x, y).A != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,415 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+// RasterizeSVG draws an SVG image at the given size without any external
+// tool. It supports the subset of SVG written by the generators of this
+// package: circles, ellipses, lines, rectangles, polylines, polygons, paths
+// and text, with solid fills and strokes, opacities and dash arrays.
+// Other elements are ignored.
+func RasterizeSVG(svgData []byte, width, height int) (*image.RGBA, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid image size %dx%d", width, height)
+	}
+	r := &svgRasterizer{
+		img:    image.NewRGBA(image.Rect(0, 0, width, height)),
+		scaleX: 1,
+		scaleY: 1,
+		faces:  make(map[faceKey]font.Face),
+	}
+	defer r.closeFaces()
+
+	dec := xml.NewDecoder(bytes.NewReader(svgData))
+	styles := []svgStyle{{}}
+	root := true
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid SVG: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			attrs := attrMap(t.Attr)
+			style := styles[len(styles)-1].inherit(attrs)
+			styles = append(styles, style)
+			if root {
+				if t.Name.Local != "svg" {
+					return nil, fmt.Errorf("invalid SVG: root element is <%s>", t.Name.Local)
+				}
+				r.setViewport(attrs, width, height)
+				root = false
+				continue
+			}
+			if t.Name.Local == "text" {
+				text, err := textContent(dec)
+				if err != nil {
+					return nil, fmt.Errorf("invalid SVG: %w", err)
+				}
+				styles = styles[:len(styles)-1]
+				r.drawText(attrs, style, text)
+				continue
+			}
+			r.drawShape(t.Name.Local, attrs, style)
+		case xml.EndElement:
+			styles = styles[:len(styles)-1]
+		}
+	}
+	if root {
+		return nil, fmt.Errorf("invalid SVG: no <svg> element")
+	}
+	return r.img, nil
+}
+
+// svgRasterizer holds the state of a rendering
+type svgRasterizer struct {
+	img            *image.RGBA
+	scaleX, scaleY float64 // Device pixels per user unit
+	raster         vector.Rasterizer
+	faces          map[faceKey]font.Face
+}
+
+// setViewport scales the user units of the SVG width and height to the
+// image size
+func (r *svgRasterizer) setViewport(attrs map[string]string, width, height int) {
+	if w := length(attrs["width"]); w > 0 {
+		r.scaleX = float64(width) / w
+	}
+	if h := length(attrs["height"]); h > 0 {
+		r.scaleY = float64(height) / h
+	}
+}
+
+// pt converts user units to device pixels
+func (r *svgRasterizer) pt(x, y float64) vec {
+	return vec{x * r.scaleX, y * r.scaleY}
+}
+
+// scale returns the mean number of device pixels per user unit, for
+// lengths that are not along an axis
+func (r *svgRasterizer) scale() float64 {
+	return (r.scaleX + r.scaleY) / 2
+}
+
+// drawShape fills and strokes a shape element
+func (r *svgRasterizer) drawShape(name string, attrs map[string]string, style svgStyle) {
+	num := func(key string) float64 { return length(attrs[key]) }
+
+	var paths []subpath
+	switch name {
+	case "circle":
+		c := r.pt(num("cx"), num("cy"))
+		paths = []subpath{ellipse(c, num("r")*r.scaleX, num("r")*r.scaleY)}
+	case "ellipse":
+		c := r.pt(num("cx"), num("cy"))
+		paths = []subpath{ellipse(c, num("rx")*r.scaleX, num("ry")*r.scaleY)}
+	case "line":
+		paths = []subpath{{points: []vec{r.pt(num("x1"), num("y1")), r.pt(num("x2"), num("y2"))}}}
+	case "rect":
+		x, y, w, h := num("x"), num("y"), num("width"), num("height")
+		paths = []subpath{{points: []vec{r.pt(x, y), r.pt(x+w, y), r.pt(x+w, y+h), r.pt(x, y+h)}, closed: true}}
+	case "polyline", "polygon":
+		points := parsePoints(attrs["points"], r.pt)
+		if len(points) < 2 {
+			return
+		}
+		paths = []subpath{{points: points, closed: name == "polygon"}}
+	case "path":
+		paths = parsePath(attrs["d"], r.pt, r.scale())
+	default:
+		return
+	}
+
+	// Lines and polylines are filled too, as SVG requires, although the
+	// generators always give them fill:none
+	if c, ok := style.paint("fill", "#000000"); ok && name != "line" {
+		r.fill(polygons(paths), c)
+	}
+	if c, ok := style.paint("stroke", "none"); ok {
+		width := style.number("stroke-width", 1) * r.scale()
+		if width <= 0 {
+			return
+		}
+		if pattern := style.dashArray(r.scale()); pattern != nil {
+			paths = dash(paths, pattern)
+		}
+		r.fill(strokeOutline(paths, width), c)
+	}
+}
+
+// polygons returns the points of subpaths, which are implicitly closed
+// when filled
+func polygons(paths []subpath) [][]vec {
+	polys := make([][]vec, len(paths))
+	for i, sp := range paths {
+		polys[i] = sp.points
+	}
+	return polys
+}
+
+// fill paints the union of polygons, rasterizing only their bounding box
+func (r *svgRasterizer) fill(polys [][]vec, c color.NRGBA) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, poly := range polys {
+		for _, p := range poly {
+			minX, maxX = math.Min(minX, p.x), math.Max(maxX, p.x)
+			minY, maxY = math.Min(minY, p.y), math.Max(maxY, p.y)
+		}
+	}
+	box := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY))).
+		Intersect(r.img.Bounds())
+	if box.Empty() {
+		return
+	}
+
+	r.raster.Reset(box.Dx(), box.Dy())
+	ox, oy := float64(box.Min.X), float64(box.Min.Y)
+	for _, poly := range polys {
+		if len(poly) < 3 {
+			continue
+		}
+		r.raster.MoveTo(float32(poly[0].x-ox), float32(poly[0].y-oy))
+		for _, p := range poly[1:] {
+			r.raster.LineTo(float32(p.x-ox), float32(p.y-oy))
+		}
+		r.raster.ClosePath()
+	}
+	r.raster.Draw(r.img, box, image.NewUniform(c), image.Point{})
+}
+
+// drawText draws a text element with the Go fonts. Bodies and points are
+// drawn from glyph outlines or labelled in ASCII, so only symbols chosen
+// for registered bodies may be missing from the fonts and drawn as boxes.
+func (r *svgRasterizer) drawText(attrs map[string]string, style svgStyle, text string) {
+	text = strings.TrimSpace(text)
+	c, ok := style.paint("fill", "#000000")
+	if !ok || text == "" {
+		return
+	}
+	size := style.number("font-size", 16) * r.scale()
+	if size < 1 {
+		return
+	}
+	weight := style["font-weight"]
+	bold := weight == "bold" || weight == "bolder" || length(weight) >= 600
+
+	face, err := r.face(faceKey{size: math.Round(size*4) / 4, bold: bold})
+	if err != nil {
+		return
+	}
+	d := font.Drawer{Dst: r.img, Src: image.NewUniform(c), Face: face}
+	p := r.pt(length(attrs["x"]), length(attrs["y"]))
+	switch style["text-anchor"] {
+	case "middle":
+		p.x -= float64(d.MeasureString(text)) / 64 / 2
+	case "end":
+		p.x -= float64(d.MeasureString(text)) / 64
+	}
+	d.Dot = fixed.Point26_6{X: fixed.Int26_6(p.x * 64), Y: fixed.Int26_6(p.y * 64)}
+	d.DrawString(text)
+}
+
+// faceKey identifies a font face of a rendering
+type faceKey struct {
+	size float64
+	bold bool
+}
+
+// goFonts parses the embedded Go fonts once
+var goFonts = sync.OnceValues(func() ([2]*opentype.Font, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	bold, err := opentype.Parse(gobold.TTF)
+	return [2]*opentype.Font{regular, bold}, err
+})
+
+// face returns a face of the Go fonts. Faces are not safe for concurrent
+// use, so each rendering has its own.
+func (r *svgRasterizer) face(key faceKey) (font.Face, error) {
+	if f, ok := r.faces[key]; ok {
+		return f, nil
+	}
+	fonts, err := goFonts()
+	if err != nil {
+		return nil, err
+	}
+	f := fonts[0]
+	if key.bold {
+		f = fonts[1]
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: key.size, DPI: 72, Hinting: font.HintingNone})
+	if err != nil {
+		return nil, err
+	}
+	r.faces[key] = face
+	return face, nil
+}
+
+func (r *svgRasterizer) closeFaces() {
+	for _, f := range r.faces {
+		_ = f.Close()
+	}
+}
+
+// textContent reads the characters of the current element up to its end
+func textContent(dec *xml.Decoder) (string, error) {
+	var sb strings.Builder
+	depth := 1
+	for depth > 0 {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			sb.Write(t)
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return sb.String(), nil
+}
+
+func attrMap(attrs []xml.Attr) map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		m[a.Name.Local] = a.Value
+	}
+	return m
+}
+
+// svgStyle holds the presentation properties of an element
+type svgStyle map[string]string
+
+// styleProperties are the presentation attributes the rasterizer reads
+var styleProperties = []string{
+	"fill", "fill-opacity", "stroke", "stroke-width", "stroke-opacity", "stroke-dasharray",
+	"opacity", "font-size", "font-weight", "text-anchor",
+}
+
+// inherit returns the style of a child element: the inherited properties
+// of its parent, overridden by its presentation attributes and then by
+// its style attribute. Opacity applies to the element alone.
+func (s svgStyle) inherit(attrs map[string]string) svgStyle {
+	out := make(svgStyle, len(s))
+	for k, v := range s {
+		if k != "opacity" {
+			out[k] = v
+		}
+	}
+	for _, k := range styleProperties {
+		if v, ok := attrs[k]; ok {
+			out[k] = strings.TrimSpace(v)
+		}
+	}
+	for decl := range strings.SplitSeq(attrs["style"], ";") {
+		if k, v, ok := strings.Cut(decl, ":"); ok {
+			out[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return out
+}
+
+// number returns a numeric property, or a default
+func (s svgStyle) number(key string, def float64) float64 {
+	n, err := strconv.ParseFloat(strings.TrimSuffix(s[key], "px"), 64)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// paint returns the colour of the fill or stroke, with its opacity, and
+// whether there is one
+func (s svgStyle) paint(key, def string) (color.NRGBA, bool) {
+	value, ok := s[key]
+	if !ok {
+		value = def
+	}
+	c, ok := parseColor(value)
+	if !ok {
+		return c, false
+	}
+	alpha := s.number("opacity", 1) * s.number(key+"-opacity", 1)
+	c.A = uint8(math.Round(float64(c.A) * math.Max(0, math.Min(1, alpha))))
+	return c, c.A > 0
+}
+
+// dashArray returns the dash lengths in device pixels, or nil for a solid
+// stroke
+func (s svgStyle) dashArray(scale float64) []float64 {
+	v := s["stroke-dasharray"]
+	if v == "" || v == "none" {
+		return nil
+	}
+	var pattern []float64
+	for _, f := range strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' }) {
+		n := length(f)
+		if n < 0 {
+			return nil
+		}
+		pattern = append(pattern, n*scale)
+	}
+	return pattern
+}
+
+// namedColors are the colour keywords accepted besides hexadecimal values
+var namedColors = map[string]color.NRGBA{
+	"black": {0, 0, 0, 255},
+	"white": {255, 255, 255, 255},
+}
+
+// parseColor reads a #rgb or #rrggbb colour or a few keywords. "none"
+// and unknown values are no paint.
+func parseColor(s string) (color.NRGBA, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if c, ok := namedColors[s]; ok {
+		return c, true
+	}
+	if !strings.HasPrefix(s, "#") {
+		return color.NRGBA{}, false
+	}
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return color.NRGBA{}, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}, true
+}
+
+// length reads a number, ignoring a px unit
+func length(s string) float64 {
+	n, _ := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "px"), 64)
+	return n
+}
@@ -0,0 +1,460 @@
+package render
+
+import (
+	"math"
+	"strconv"
+)
+
+// vec is a point or a direction in device pixels
+type vec struct {
+	x, y float64
+}
+
+func (a vec) add(b vec) vec        { return vec{a.x + b.x, a.y + b.y} }
+func (a vec) sub(b vec) vec        { return vec{a.x - b.x, a.y - b.y} }
+func (a vec) mul(k float64) vec    { return vec{a.x * k, a.y * k} }
+func (a vec) length() float64      { return math.Hypot(a.x, a.y) }
+func lerp(a, b vec, t float64) vec { return a.add(b.sub(a).mul(t)) }
+
+// subpath is a flattened part of a shape
+type subpath struct {
+	points []vec
+	closed bool
+}
+
+// Flattening resolution in device pixels: curves are cut into segments of
+// about this length
+const flatStep = 2.0
+
+// segmentsFor returns the number of segments to flatten a curve of the
+// given approximate length
+func segmentsFor(length float64) int {
+	return min(max(int(math.Ceil(length/flatStep)), 2), 256)
+}
+
+// pathScanner reads the tokens of SVG path data and point lists
+type pathScanner struct {
+	s string
+	i int
+}
+
+func (p *pathScanner) skipSeparators() {
+	for p.i < len(p.s) {
+		switch p.s[p.i] {
+		case ' ', ',', '\t', '\n', '\r':
+			p.i++
+		default:
+			return
+		}
+	}
+}
+
+// command returns the next command letter, if the next token is one
+func (p *pathScanner) command() (byte, bool) {
+	p.skipSeparators()
+	if p.i < len(p.s) {
+		c := p.s[p.i]
+		if (c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') && c != 'e' && c != 'E' {
+			p.i++
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// number reads the next number; numbers may follow each other without a
+// separator, as in "1.5.5" or "3-2"
+func (p *pathScanner) number() (float64, bool) {
+	p.skipSeparators()
+	start := p.i
+	if p.i < len(p.s) && (p.s[p.i] == '+' || p.s[p.i] == '-') {
+		p.i++
+	}
+	digits, dot := false, false
+	for ; p.i < len(p.s); p.i++ {
+		if c := p.s[p.i]; c >= '0' && c <= '9' {
+			digits = true
+		} else if c == '.' && !dot {
+			dot = true
+		} else {
+			break
+		}
+	}
+	if digits && p.i < len(p.s) && (p.s[p.i] == 'e' || p.s[p.i] == 'E') {
+		j := p.i + 1
+		if j < len(p.s) && (p.s[j] == '+' || p.s[j] == '-') {
+			j++
+		}
+		if j < len(p.s) && p.s[j] >= '0' && p.s[j] <= '9' {
+			for j < len(p.s) && p.s[j] >= '0' && p.s[j] <= '9' {
+				j++
+			}
+			p.i = j
+		}
+	}
+	if !digits {
+		p.i = start
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(p.s[start:p.i], 64)
+	return n, err == nil
+}
+
+// flag reads an arc flag, which may be written without separator
+func (p *pathScanner) flag() (bool, bool) {
+	p.skipSeparators()
+	if p.i < len(p.s) && (p.s[p.i] == '0' || p.s[p.i] == '1') {
+		p.i++
+		return p.s[p.i-1] == '1', true
+	}
+	return false, false
+}
+
+// numbers reads n numbers, or none if they are not all there
+func (p *pathScanner) numbers(n int) ([]float64, bool) {
+	out := make([]float64, n)
+	for i := range out {
+		v, ok := p.number()
+		if !ok {
+			return nil, false
+		}
+		out[i] = v
+	}
+	return out, true
+}
+
+// parsePoints reads the points attribute of a polyline or polygon
+func parsePoints(s string, pt func(x, y float64) vec) []vec {
+	sc := pathScanner{s: s}
+	var points []vec
+	for {
+		xy, ok := sc.numbers(2)
+		if !ok {
+			return points
+		}
+		points = append(points, pt(xy[0], xy[1]))
+	}
+}
+
+// pathBuilder flattens path commands into subpaths
+type pathBuilder struct {
+	pt      func(x, y float64) vec // User to device coordinates
+	scale   float64                // Mean device pixels per user unit
+	paths   []subpath
+	current []vec
+}
+
+func (b *pathBuilder) moveTo(p vec) {
+	b.flush(false)
+	b.current = []vec{p}
+}
+
+func (b *pathBuilder) lineTo(p vec) {
+	if len(b.current) == 0 {
+		b.current = []vec{p}
+		return
+	}
+	b.current = append(b.current, p)
+}
+
+func (b *pathBuilder) flush(closed bool) {
+	if len(b.current) > 1 {
+		b.paths = append(b.paths, subpath{points: b.current, closed: closed})
+	}
+	b.current = nil
+}
+
+func (b *pathBuilder) last() vec {
+	if len(b.current) == 0 {
+		return vec{}
+	}
+	return b.current[len(b.current)-1]
+}
+
+func (b *pathBuilder) cubicTo(c1, c2, p vec) {
+	p0 := b.last()
+	n := segmentsFor(c1.sub(p0).length() + c2.sub(c1).length() + p.sub(c2).length())
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		a, bb, c := lerp(p0, c1, t), lerp(c1, c2, t), lerp(c2, p, t)
+		b.lineTo(lerp(lerp(a, bb, t), lerp(bb, c, t), t))
+	}
+}
+
+func (b *pathBuilder) quadTo(c, p vec) {
+	p0 := b.last()
+	n := segmentsFor(c.sub(p0).length() + p.sub(c).length())
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		b.lineTo(lerp(lerp(p0, c, t), lerp(c, p, t), t))
+	}
+}
+
+// arcTo flattens an elliptical arc given in user units, converting its
+// endpoint parameters to a centre and angles (SVG 1.1, appendix F.6.5)
+func (b *pathBuilder) arcTo(from vec, rx, ry, rotation float64, large, sweep bool, to vec) {
+	if rx == 0 || ry == 0 || from == to {
+		b.lineTo(b.pt(to.x, to.y))
+		return
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	phi := rotation * math.Pi / 180
+	cos, sin := math.Cos(phi), math.Sin(phi)
+
+	dx, dy := (from.x-to.x)/2, (from.y-to.y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+	if l := x1*x1/(rx*rx) + y1*y1/(ry*ry); l > 1 {
+		rx, ry = rx*math.Sqrt(l), ry*math.Sqrt(l)
+	}
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	k := math.Sqrt(math.Max(num, 0) / den)
+	if large == sweep {
+		k = -k
+	}
+	cx1, cy1 := k*rx*y1/ry, -k*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (from.x+to.x)/2
+	cy := sin*cx1 + cos*cy1 + (from.y+to.y)/2
+
+	angle := func(ux, uy float64) float64 { return math.Atan2(uy, ux) }
+	start := angle((x1-cx1)/rx, (y1-cy1)/ry)
+	delta := angle((-x1-cx1)/rx, (-y1-cy1)/ry) - start
+	switch {
+	case sweep && delta < 0:
+		delta += 2 * math.Pi
+	case !sweep && delta > 0:
+		delta -= 2 * math.Pi
+	}
+
+	n := segmentsFor(math.Abs(delta) * math.Max(rx, ry) * b.scale)
+	for i := 1; i <= n; i++ {
+		a := start + delta*float64(i)/float64(n)
+		ex, ey := rx*math.Cos(a), ry*math.Sin(a)
+		b.lineTo(b.pt(cos*ex-sin*ey+cx, sin*ex+cos*ey+cy))
+	}
+}
+
+// parsePath flattens SVG path data into device space subpaths. Malformed
+// data ends the path where the error is, as SVG renderers do.
+func parsePath(d string, pt func(x, y float64) vec, scale float64) []subpath {
+	b := &pathBuilder{pt: pt, scale: scale}
+	sc := pathScanner{s: d}
+
+	// Positions are tracked in user units, for relative commands and arcs
+	var cur, start, lastCtrl vec
+	var cmd, prev byte
+	for {
+		if c, ok := sc.command(); ok {
+			cmd = c
+		} else if cmd == 0 || cmd == 'z' || cmd == 'Z' {
+			break
+		}
+		rel := cmd >= 'a'
+		base := vec{}
+		if rel {
+			base = cur
+		}
+		at := func(x, y float64) vec { return vec{base.x + x, base.y + y} }
+
+		ok := true
+		switch cmd | 0x20 {
+		case 'm':
+			var v []float64
+			if v, ok = sc.numbers(2); ok {
+				cur = at(v[0], v[1])
+				start = cur
+				b.moveTo(pt(cur.x, cur.y))
+				// Further coordinate pairs are implicit line commands
+				if rel {
+					cmd = 'l'
+				} else {
+					cmd = 'L'
+				}
+			}
+		case 'l':
+			var v []float64
+			if v, ok = sc.numbers(2); ok {
+				cur = at(v[0], v[1])
+				b.lineTo(pt(cur.x, cur.y))
+			}
+		case 'h':
+			var x float64
+			if x, ok = sc.number(); ok {
+				cur.x = base.x + x
+				b.lineTo(pt(cur.x, cur.y))
+			}
+		case 'v':
+			var y float64
+			if y, ok = sc.number(); ok {
+				cur.y = base.y + y
+				b.lineTo(pt(cur.x, cur.y))
+			}
+		case 'c', 's':
+			var c1 vec
+			var v []float64
+			if cmd|0x20 == 'c' {
+				if v, ok = sc.numbers(6); ok {
+					c1, v = at(v[0], v[1]), v[2:]
+				}
+			} else if v, ok = sc.numbers(4); ok {
+				c1 = cur
+				if prev|0x20 == 'c' || prev|0x20 == 's' {
+					c1 = cur.add(cur.sub(lastCtrl))
+				}
+			}
+			if ok {
+				c2, end := at(v[0], v[1]), at(v[2], v[3])
+				b.cubicTo(pt(c1.x, c1.y), pt(c2.x, c2.y), pt(end.x, end.y))
+				lastCtrl, cur = c2, end
+			}
+		case 'q', 't':
+			var c vec
+			var v []float64
+			if cmd|0x20 == 'q' {
+				if v, ok = sc.numbers(4); ok {
+					c, v = at(v[0], v[1]), v[2:]
+				}
+			} else if v, ok = sc.numbers(2); ok {
+				c = cur
+				if prev|0x20 == 'q' || prev|0x20 == 't' {
+					c = cur.add(cur.sub(lastCtrl))
+				}
+			}
+			if ok {
+				end := at(v[0], v[1])
+				b.quadTo(pt(c.x, c.y), pt(end.x, end.y))
+				lastCtrl, cur = c, end
+			}
+		case 'a':
+			var r []float64
+			var large, sweep bool
+			r, ok = sc.numbers(3)
+			if ok {
+				large, ok = sc.flag()
+			}
+			if ok {
+				sweep, ok = sc.flag()
+			}
+			var v []float64
+			if ok {
+				v, ok = sc.numbers(2)
+			}
+			if ok {
+				end := at(v[0], v[1])
+				b.arcTo(cur, r[0], r[1], r[2], large, sweep, end)
+				cur = end
+			}
+		case 'z':
+			b.flush(true)
+			cur = start
+			b.current = []vec{pt(cur.x, cur.y)}
+		default:
+			ok = false
+		}
+		if !ok {
+			break
+		}
+		prev = cmd
+	}
+	b.flush(false)
+	return b.paths
+}
+
+// dash cuts subpaths into the dashes of a dash array, in device pixels
+func dash(paths []subpath, pattern []float64) []subpath {
+	total := 0.0
+	for _, d := range pattern {
+		total += d
+	}
+	if total <= 0 {
+		return paths
+	}
+	if len(pattern)%2 == 1 {
+		pattern = append(pattern, pattern...)
+	}
+
+	var out []subpath
+	for _, sp := range paths {
+		points := sp.points
+		if sp.closed {
+			points = append(points[:len(points):len(points)], points[0])
+		}
+		index, left, on := 0, pattern[0], true
+		current := []vec{points[0]}
+		for i := 1; i < len(points); i++ {
+			a, b := points[i-1], points[i]
+			seg := b.sub(a).length()
+			pos := 0.0
+			for seg-pos > left {
+				pos += left
+				p := lerp(a, b, pos/seg)
+				if on {
+					out = append(out, subpath{points: append(current, p)})
+				}
+				current = []vec{p}
+				index = (index + 1) % len(pattern)
+				left, on = pattern[index], !on
+			}
+			left -= seg - pos
+			current = append(current, b)
+		}
+		if on && len(current) > 1 {
+			out = append(out, subpath{points: current})
+		}
+	}
+	return out
+}
+
+// joinSides is the number of sides of the polygons rounding the joins
+const joinSides = 12
+
+// strokeOutline returns polygons covering the stroke of subpaths: a
+// rectangle per segment and a disc per join. Every polygon turns the
+// same way, so that their overlaps add up instead of cancelling out.
+func strokeOutline(paths []subpath, width float64) [][]vec {
+	hw := width / 2
+	var polys [][]vec
+	for _, sp := range paths {
+		points := sp.points
+		if sp.closed {
+			points = append(points[:len(points):len(points)], points[0])
+		}
+		for i := 1; i < len(points); i++ {
+			a, b := points[i-1], points[i]
+			d := b.sub(a)
+			l := d.length()
+			if l == 0 {
+				continue
+			}
+			n := vec{-d.y / l * hw, d.x / l * hw}
+			polys = append(polys, []vec{a.add(n), b.add(n), b.sub(n), a.sub(n)})
+			if width > 1 && (i < len(points)-1 || sp.closed) {
+				polys = append(polys, disc(b, hw))
+			}
+		}
+	}
+	return polys
+}
+
+// disc returns a polygon approximating a disc, turning the same way as the
+// segment rectangles of strokeOutline
+func disc(c vec, r float64) []vec {
+	points := make([]vec, joinSides)
+	for i := range points {
+		a := -2 * math.Pi * float64(i) / joinSides
+		points[i] = vec{c.x + r*math.Cos(a), c.y + r*math.Sin(a)}
+	}
+	return points
+}
+
+// ellipse returns a closed subpath approximating an ellipse
+func ellipse(c vec, rx, ry float64) subpath {
+	n := max(segmentsFor(2*math.Pi*math.Max(rx, ry)), 12)
+	points := make([]vec, n)
+	for i := range points {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		points[i] = vec{c.x + rx*math.Cos(a), c.y + ry*math.Sin(a)}
+	}
+	return subpath{points: points, closed: true}
+}
@@ -0,0 +1,36 @@
+package render
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/ansi/sixel"
+	"golang.org/x/image/draw"
+)
+
+// sixelOutput displays images as DEC sixel graphics (xterm, foot, mlterm)
+type sixelOutput struct {
+	cell       cellSize
+	background color.Color
+}
+
+// Place encodes each row of the image as a sixel strip. Sixel pixels have
+// no transparency, so the image is laid on the terminal background.
+func (s sixelOutput) Place(_ io.Writer, img image.Image, cols, rows int) ([]string, error) {
+	fitted := fitImage(img, cols, rows, s.cell)
+	return stripRows(fitted, cols, rows, s.cell, func(strip *image.RGBA) (string, error) {
+		opaque := image.NewRGBA(strip.Bounds())
+		draw.Draw(opaque, opaque.Bounds(), image.NewUniform(s.background), image.Point{}, draw.Src)
+		draw.Draw(opaque, opaque.Bounds(), strip, image.Point{}, draw.Over)
+
+		var payload bytes.Buffer
+		if err := (&sixel.Encoder{}).Encode(&payload, opaque); err != nil {
+			return "", err
+		}
+		// P2=1 leaves the pixels of an incomplete last band untouched
+		return ansi.SixelGraphics(0, 1, 0, payload.Bytes()), nil
+	})
+}
@@ -5,14 +5,16 @@ import (
 
 	svg "github.com/ajstarks/svgo"
 
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
 	"github.com/ctrl-vfr/astral-tui/pkg/position"
 )
 
 // SVGWheelGenerator generates SVG zodiac wheels.
 type SVGWheelGenerator struct {
-	size   int
-	center int
-	radius int
+	size     int
+	center   int
+	radius   int
+	patterns []horoscope.Pattern
 }
 
 // NewSVGWheelGenerator creates a new SVG generator.
@@ -24,6 +26,12 @@ func NewSVGWheelGenerator(size int) *SVGWheelGenerator {
 	}
 }
 
+// SetPatterns sets the natal aspect patterns to highlight.
+func (g *SVGWheelGenerator) SetPatterns(patterns []horoscope.Pattern) *SVGWheelGenerator {
+	g.patterns = patterns
+	return g
+}
+
 // Generate creates an SVG zodiac wheel (natal only).
 func (g *SVGWheelGenerator) Generate(positions []position.Position) []byte {
 	return g.GenerateWithTransits(positions, nil)
@@ -39,6 +47,7 @@ func (g *SVGWheelGenerator) GenerateWithTransits(natal, transits []position.Posi
 	g.drawZodiacSegments(canvas)
 	g.drawInnerCircle(canvas)
 	g.drawAxes(canvas)
+	g.drawPatterns(canvas, natal)
 	g.drawPlanetsRing(canvas, natal, 0.55, false)
 	if len(transits) > 0 {
 		g.drawPlanetsRing(canvas, transits, 0.55, true)
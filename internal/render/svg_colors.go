@@ -34,6 +34,19 @@ func getElementColor(elem horoscope.Element) string {
 	}
 }
 
+func getPatternColor(pattern horoscope.PatternType) string {
+	switch pattern {
+	case horoscope.GrandTrine, horoscope.Kite, horoscope.MysticRectangle:
+		return svgAir
+	case horoscope.Yod:
+		return svgPurple
+	case horoscope.StelliumSign, horoscope.StelliumHouse:
+		return svgPrimary
+	default:
+		return svgAccent
+	}
+}
+
 func getPlanetSVGColor(body position.CelestialBody) string {
 	switch body {
 	case position.Sun:
@@ -58,6 +71,14 @@ func getPlanetSVGColor(body position.CelestialBody) string {
 		return "#DC143C" // Crimson
 	case position.Chiron, position.Ceres, position.Pallas, position.Juno, position.Vesta:
 		return svgTextLight // Beige for asteroids
+	case position.Lilith:
+		return "#9B59B6" // Amethyst
+	case position.NorthNode, position.SouthNode, position.Vertex, position.AntiVertex, position.EastPoint:
+		return "#C0C0C0" // Silver for calculated points
+	case position.PartOfFortune, position.PartOfSpirit, position.PartOfEros, position.PartOfNecessity:
+		return svgAir // Gold for lots
+	case position.Earth:
+		return "#3CB371" // Medium sea green
 	default:
 		return svgTextLight
 	}
@@ -0,0 +1,160 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	svg "github.com/ajstarks/svgo"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// SVGDialGenerator generates SVG 90° dials, the tool of Cosmobiology and
+// Uranian astrology where hard aspects and midpoints line up.
+type SVGDialGenerator struct {
+	size       int
+	center     int
+	radius     int
+	pointer    float64
+	hasPointer bool
+	orb        float64
+}
+
+// NewSVGDialGenerator creates a new dial generator.
+func NewSVGDialGenerator(size int) *SVGDialGenerator {
+	return &SVGDialGenerator{
+		size:   size,
+		center: size / 2,
+		radius: size/2 - 20,
+		orb:    horoscope.DefaultMidpointOrb,
+	}
+}
+
+// SetPointer points the dial at a longitude, marking the orb around it
+// and the opposite end of the pointer (45° further on the dial).
+func (g *SVGDialGenerator) SetPointer(longitude, orb float64) *SVGDialGenerator {
+	g.pointer = horoscope.DialPosition(longitude)
+	g.hasPointer = true
+	g.orb = orb
+	return g
+}
+
+// Generate creates an SVG 90° dial with the given positions.
+func (g *SVGDialGenerator) Generate(positions []position.Position) []byte {
+	var buf bytes.Buffer
+	canvas := svg.New(&buf)
+
+	canvas.Start(g.size, g.size)
+	g.drawScale(canvas)
+	g.drawModes(canvas)
+	if g.hasPointer {
+		g.drawPointer(canvas)
+	}
+	g.drawBodies(canvas, positions)
+	canvas.Circle(g.center, g.center, 4, fmt.Sprintf("fill:%s", svgBright))
+	canvas.End()
+
+	return buf.Bytes()
+}
+
+// polar converts a radius and dial position to canvas coordinates. The dial
+// starts at the top and grows counterclockwise, one dial degree being four
+// degrees of the circle.
+func (g *SVGDialGenerator) polar(radius, dial float64) (int, int) {
+	angle := dial * 4 * math.Pi / 180
+	return g.center - int(radius*math.Sin(angle)), g.center - int(radius*math.Cos(angle))
+}
+
+// drawScale draws the rim of the dial with a tick for each degree
+func (g *SVGDialGenerator) drawScale(canvas *svg.SVG) {
+	r := float64(g.radius)
+	canvas.Circle(g.center, g.center, g.radius, fmt.Sprintf("fill:none;stroke:%s;stroke-width:1", svgPrimary))
+	canvas.Circle(g.center, g.center, g.radius-40, fmt.Sprintf("fill:none;stroke:%s;stroke-width:1", svgBorder))
+
+	for deg := 0; deg < int(horoscope.DialSpan); deg++ {
+		length := 6.0
+		if deg%5 == 0 {
+			length = 12
+		}
+		x1, y1 := g.polar(r, float64(deg))
+		x2, y2 := g.polar(r-length, float64(deg))
+		canvas.Line(x1, y1, x2, y2, fmt.Sprintf("stroke:%s;stroke-width:1", svgTextLight))
+
+		if deg%5 == 0 {
+			x, y := g.polar(r-24, float64(deg))
+			canvas.Text(x, y+4, fmt.Sprintf("%d", deg), fmt.Sprintf("font-size:10px;fill:%s;text-anchor:middle", svgTextLight))
+		}
+	}
+}
+
+// drawModes divides the dial into its cardinal, fixed and mutable thirds,
+// labelled with the first sign of each
+func (g *SVGDialGenerator) drawModes(canvas *svg.SVG) {
+	inner := float64(g.radius - 40)
+	for _, sign := range []horoscope.ZodiacSign{horoscope.Aries, horoscope.Taurus, horoscope.Gemini} {
+		start := float64(sign) * 30
+		x1, y1 := g.polar(inner, start)
+		canvas.Line(g.center, g.center, x1, y1, fmt.Sprintf("stroke:%s;stroke-width:1;stroke-dasharray:5,3", svgPurple))
+
+		x, y := g.polar(inner-22, start+15)
+		drawSymbol(canvas, GetZodiacPath(sign), x, y+7, 26, getElementColor(sign.Element()))
+	}
+}
+
+// drawPointer draws the pointer, its orb and its opposite end
+func (g *SVGDialGenerator) drawPointer(canvas *svg.SVG) {
+	r := float64(g.radius - 40)
+	x1, y1 := g.polar(r, g.pointer)
+	x2, y2 := g.polar(r, g.pointer+horoscope.DialSpan/2)
+	canvas.Line(x2, y2, x1, y1, fmt.Sprintf("stroke:%s;stroke-width:2", svgAccent))
+
+	if g.orb <= 0 {
+		return
+	}
+	span := g.orb * 8
+	for _, end := range []float64{g.pointer, g.pointer + horoscope.DialSpan/2} {
+		ax, ay := g.polar(r, end-g.orb)
+		bx, by := g.polar(r, end+g.orb)
+		canvas.Arc(ax, ay, int(r), int(r), 0, span > 180, false, bx, by,
+			fmt.Sprintf("fill:none;stroke:%s;stroke-width:8;opacity:0.35", svgAccent))
+	}
+}
+
+// drawBodies draws the bodies at their dial positions
+func (g *SVGDialGenerator) drawBodies(canvas *svg.SVG, positions []position.Position) {
+	planetRadius := float64(g.radius) * 0.7
+
+	// Bodies are placed by their angle on the circle, which reuses the
+	// overlap handling of the wheel
+	placed := make([]position.Position, len(positions))
+	for i, pos := range positions {
+		placed[i] = pos
+		placed[i].EclipticLongitude = horoscope.DialPosition(pos.EclipticLongitude) * 4
+	}
+	sort.Slice(placed, func(i, j int) bool {
+		return placed[i].EclipticLongitude < placed[j].EclipticLongitude
+	})
+	offsets := calculateRadialOffsets(placed, 20.0)
+
+	for i, pos := range placed {
+		dial := pos.EclipticLongitude / 4
+		tx, ty := g.polar(float64(g.radius-40), dial)
+		mx, my := g.polar(float64(g.radius-48), dial)
+		canvas.Line(tx, ty, mx, my, fmt.Sprintf("stroke:%s;stroke-width:2", getPlanetSVGColor(pos.Body)))
+
+		x, y := g.polar(planetRadius+offsets[i], dial)
+		size := 25.0
+		if isMinorBody(pos.Body) {
+			size = 18.0
+		}
+		color := getPlanetSVGColor(pos.Body)
+		if path := GetPlanetPath(pos.Body); path != "" {
+			drawSymbol(canvas, path, x, y, size, color)
+		} else {
+			drawLabel(canvas, pos.Body.Symbol(), x, y, size, color)
+		}
+	}
+}
@@ -75,26 +75,92 @@ func (g *SVGWheelGenerator) drawAxes(canvas *svg.SVG) {
 	canvas.Text(g.center-innerRadius-10, g.center+4, "DSC", fmt.Sprintf("font-size:10px;fill:%s;text-anchor:end", svgTextLight))
 }
 
-// drawPlanetsRing draws the planets ring of the wheel
-func (g *SVGWheelGenerator) drawPlanetsRing(canvas *svg.SVG, positions []position.Position, radiusFactor float64, isTransit bool) {
-	planetRadius := float64(g.radius) * radiusFactor
+// drawPatterns highlights aspect patterns as polygons and stellia as arcs
+func (g *SVGWheelGenerator) drawPatterns(canvas *svg.SVG, natal []position.Position) {
+	if len(g.patterns) == 0 {
+		return
+	}
 
-	maxBody := position.Vesta
-	if isTransit {
-		maxBody = position.Vesta
+	longitudes := make(map[position.CelestialBody]float64)
+	for _, pos := range natal {
+		longitudes[pos.Body] = pos.EclipticLongitude
 	}
 
-	// Filter and sort positions by longitude
-	var filtered []position.Position
-	for _, pos := range positions {
-		// Skip nodes, keep planets and asteroids
-		if pos.Body == position.NorthNode || pos.Body == position.SouthNode {
+	lineRadius := float64(g.radius) * 0.38
+	arcRadius := float64(g.radius) * 0.65
+	for _, p := range g.patterns {
+		color := getPatternColor(p.Type)
+
+		if p.Type == horoscope.StelliumSign || p.Type == horoscope.StelliumHouse {
+			start, end := stelliumArc(p, longitudes)
+			span := math.Mod(end-start+360, 360)
+			if span == 0 {
+				continue
+			}
+			x1, y1 := g.polar(arcRadius, start)
+			x2, y2 := g.polar(arcRadius, end)
+			// Longitudes grow clockwise on the wheel, hence the sweep flag
+			canvas.Arc(x1, y1, int(arcRadius), int(arcRadius), 0, span > 180, true, x2, y2,
+				fmt.Sprintf("fill:none;stroke:%s;stroke-width:6;opacity:0.35", color))
+			continue
+		}
+
+		xs := make([]int, 0, len(p.Bodies))
+		ys := make([]int, 0, len(p.Bodies))
+		for _, body := range p.Bodies {
+			lon, ok := longitudes[body]
+			if !ok {
+				continue
+			}
+			x, y := g.polar(lineRadius, lon)
+			xs = append(xs, x)
+			ys = append(ys, y)
+		}
+		if len(xs) < 3 {
 			continue
 		}
-		if pos.Body <= maxBody {
-			filtered = append(filtered, pos)
+		canvas.Polygon(xs, ys, fmt.Sprintf("fill:%s;fill-opacity:0.08;stroke:%s;stroke-width:1.5;stroke-opacity:0.8", color, color))
+	}
+}
+
+// stelliumArc returns the start and end longitudes of the smallest arc holding the stellium
+func stelliumArc(p horoscope.Pattern, longitudes map[position.CelestialBody]float64) (float64, float64) {
+	var lons []float64
+	for _, body := range p.Bodies {
+		if lon, ok := longitudes[body]; ok {
+			lons = append(lons, lon)
+		}
+	}
+	if len(lons) == 0 {
+		return 0, 0
+	}
+	sort.Float64s(lons)
+
+	// The arc starts right after the largest gap between consecutive bodies
+	start, end := lons[0], lons[len(lons)-1]
+	largestGap := lons[0] + 360 - lons[len(lons)-1]
+	for i := 1; i < len(lons); i++ {
+		if gap := lons[i] - lons[i-1]; gap > largestGap {
+			largestGap = gap
+			start, end = lons[i], lons[i-1]
 		}
 	}
+	return start, end
+}
+
+// polar converts a radius and ecliptic longitude to canvas coordinates
+func (g *SVGWheelGenerator) polar(radius, longitude float64) (int, int) {
+	angle := (90 - longitude) * math.Pi / 180
+	return g.center + int(radius*math.Cos(angle)), g.center - int(radius*math.Sin(angle))
+}
+
+// drawPlanetsRing draws the planets ring of the wheel
+func (g *SVGWheelGenerator) drawPlanetsRing(canvas *svg.SVG, positions []position.Position, radiusFactor float64, isTransit bool) {
+	planetRadius := float64(g.radius) * radiusFactor
+
+	// Sort positions by longitude
+	filtered := make([]position.Position, len(positions))
+	copy(filtered, positions)
 	sort.Slice(filtered, func(i, j int) bool {
 		return filtered[i].EclipticLongitude < filtered[j].EclipticLongitude
 	})
@@ -114,20 +180,37 @@ func (g *SVGWheelGenerator) drawPlanetsRing(canvas *svg.SVG, positions []positio
 		if isTransit {
 			color = svgAccent
 			size = 20.0
-			if pos.Body >= position.Chiron {
+			if isMinorBody(pos.Body) {
 				size = 16.0
 			}
 		} else {
 			color = getPlanetSVGColor(pos.Body)
 			size = 25.0
-			if pos.Body >= position.Chiron {
+			if isMinorBody(pos.Body) {
 				size = 18.0
 			}
 		}
-		drawSymbol(canvas, GetPlanetPath(pos.Body), x, y, size, color)
+
+		if path := GetPlanetPath(pos.Body); path != "" {
+			drawSymbol(canvas, path, x, y, size, color)
+		} else {
+			drawLabel(canvas, pos.Body.Symbol(), x, y, size, color)
+		}
 	}
 }
 
+// isMinorBody reports whether a body is drawn with a smaller glyph
+func isMinorBody(body position.CelestialBody) bool {
+	return body >= position.NorthNode && body != position.Earth
+}
+
+// drawLabel draws a text label for bodies and points without a glyph outline
+func drawLabel(canvas *svg.SVG, label string, cx, cy int, size float64, color string) {
+	fontSize := size * 0.6
+	canvas.Text(cx, cy+int(fontSize/3), label,
+		fmt.Sprintf("font-size:%.0fpx;font-weight:bold;fill:%s;text-anchor:middle", fontSize, color))
+}
+
 // calculateRadialOffsets calculates the radial offsets to avoid overlapping
 func calculateRadialOffsets(positions []position.Position, threshold float64) []float64 {
 	offsets := make([]float64, len(positions))
@@ -0,0 +1,192 @@
+package render
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	svg "github.com/ajstarks/svgo"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/astrocarto"
+)
+
+//go:embed world/outline.txt
+var worldOutlineData string
+
+// worldOutline holds the coastline polylines as longitude/latitude pairs
+var worldOutline = parseOutline(worldOutlineData)
+
+// parseOutline reads the embedded coastline, one polyline per line
+func parseOutline(data string) [][][2]float64 {
+	var lines [][][2]float64
+	for _, row := range strings.Split(data, "\n") {
+		row = strings.TrimSpace(row)
+		if row == "" || strings.HasPrefix(row, "#") {
+			continue
+		}
+		var line [][2]float64
+		for _, pair := range strings.Fields(row) {
+			lon, lat, ok := strings.Cut(pair, ",")
+			if !ok {
+				continue
+			}
+			x, errX := strconv.ParseFloat(lon, 64)
+			y, errY := strconv.ParseFloat(lat, 64)
+			if errX == nil && errY == nil {
+				line = append(line, [2]float64{x, y})
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Latitudes near which ASC and DSC lines are labelled
+var mapLabelLatitude = map[astrocarto.Angle]float64{
+	astrocarto.Ascendant:  50,
+	astrocarto.Descendant: -50,
+}
+
+type mapMarker struct {
+	latitude, longitude float64
+	label               string
+}
+
+// SVGMapGenerator generates equirectangular world maps with
+// astrocartography lines.
+type SVGMapGenerator struct {
+	width   int
+	height  int
+	markers []mapMarker
+}
+
+// NewSVGMapGenerator creates a new map generator. The map is half as
+// high as it is wide.
+func NewSVGMapGenerator(width int) *SVGMapGenerator {
+	return &SVGMapGenerator{width: width, height: width / 2}
+}
+
+// AddMarker marks a place on the map, such as the birth place or a city.
+func (g *SVGMapGenerator) AddMarker(latitude, longitude float64, label string) *SVGMapGenerator {
+	g.markers = append(g.markers, mapMarker{latitude, longitude, label})
+	return g
+}
+
+// Size returns the width and height of the map in pixels.
+func (g *SVGMapGenerator) Size() (int, int) {
+	return g.width, g.height
+}
+
+// Generate creates an SVG world map with the given lines.
+func (g *SVGMapGenerator) Generate(lines []astrocarto.Line) []byte {
+	var buf bytes.Buffer
+	canvas := svg.New(&buf)
+
+	canvas.Start(g.width, g.height)
+	canvas.Rect(0, 0, g.width, g.height, fmt.Sprintf("fill:%s;opacity:0.3", svgBorder))
+	g.drawGraticule(canvas)
+	g.drawCoastline(canvas)
+	for i, line := range lines {
+		g.drawLine(canvas, line, i)
+	}
+	g.drawMarkers(canvas)
+	canvas.End()
+
+	return buf.Bytes()
+}
+
+// project converts a longitude and latitude to canvas coordinates
+func (g *SVGMapGenerator) project(longitude, latitude float64) (int, int) {
+	x := (longitude + 180) / 360 * float64(g.width)
+	y := (90 - latitude) / 180 * float64(g.height)
+	return int(math.Round(x)), int(math.Round(y))
+}
+
+// drawGraticule draws meridians and parallels every 30°
+func (g *SVGMapGenerator) drawGraticule(canvas *svg.SVG) {
+	style := fmt.Sprintf("stroke:%s;stroke-width:1;stroke-dasharray:2,4", svgPurple)
+	for lon := -150.0; lon < 180; lon += 30 {
+		x1, y1 := g.project(lon, 90)
+		x2, y2 := g.project(lon, -90)
+		canvas.Line(x1, y1, x2, y2, style)
+	}
+	for lat := -60.0; lat <= 60; lat += 30 {
+		x1, y1 := g.project(-180, lat)
+		x2, y2 := g.project(180, lat)
+		if lat == 0 {
+			canvas.Line(x1, y1, x2, y2, fmt.Sprintf("stroke:%s;stroke-width:1", svgPurple))
+			continue
+		}
+		canvas.Line(x1, y1, x2, y2, style)
+	}
+}
+
+// drawCoastline draws the embedded world outline
+func (g *SVGMapGenerator) drawCoastline(canvas *svg.SVG) {
+	style := fmt.Sprintf("fill:none;stroke:%s;stroke-width:1;opacity:0.7", svgTextLight)
+	for _, line := range worldOutline {
+		xs := make([]int, len(line))
+		ys := make([]int, len(line))
+		for i, p := range line {
+			xs[i], ys[i] = g.project(p[0], p[1])
+		}
+		canvas.Polyline(xs, ys, style)
+	}
+}
+
+// drawLine draws an astrocartography line with its label. IC and DSC
+// lines are dashed.
+func (g *SVGMapGenerator) drawLine(canvas *svg.SVG, line astrocarto.Line, index int) {
+	color := getPlanetSVGColor(line.Body)
+	style := fmt.Sprintf("fill:none;stroke:%s;stroke-width:1.5", color)
+	if line.Angle == astrocarto.ImumCoeli || line.Angle == astrocarto.Descendant {
+		style += ";stroke-dasharray:6,4"
+	}
+
+	var label astrocarto.Point
+	best := math.Inf(1)
+	for _, seg := range line.Segments {
+		xs := make([]int, len(seg))
+		ys := make([]int, len(seg))
+		for i, p := range seg {
+			xs[i], ys[i] = g.project(p.Longitude, p.Latitude)
+			if d := math.Abs(p.Latitude - mapLabelLatitude[line.Angle]); d < best {
+				best, label = d, p
+			}
+		}
+		canvas.Polyline(xs, ys, style)
+	}
+	if math.IsInf(best, 1) {
+		return
+	}
+
+	x, y := g.project(label.Longitude, label.Latitude)
+	// Meridian lines are labelled along the top and bottom edges,
+	// staggered so that neighbouring labels do not overlap
+	switch line.Angle {
+	case astrocarto.Midheaven:
+		y = 14 + (index/4%3)*16
+	case astrocarto.ImumCoeli:
+		y = g.height - 14 - (index/4%3)*16
+	}
+	if path := GetPlanetPath(line.Body); path != "" {
+		drawSymbol(canvas, path, x, y, 16, color)
+	} else {
+		drawLabel(canvas, line.Body.Symbol(), x, y, 16, color)
+	}
+	canvas.Text(x+9, y+4, line.Angle.String(), fmt.Sprintf("font-size:9px;fill:%s", color))
+}
+
+// drawMarkers draws the marked places
+func (g *SVGMapGenerator) drawMarkers(canvas *svg.SVG) {
+	for _, m := range g.markers {
+		x, y := g.project(m.longitude, m.latitude)
+		canvas.Circle(x, y, 4, fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1", svgAccent, svgTextLight))
+		if m.label != "" {
+			canvas.Text(x+7, y-5, m.label, fmt.Sprintf("font-size:11px;fill:%s", svgTextLight))
+		}
+	}
+}
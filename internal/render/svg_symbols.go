@@ -61,6 +61,10 @@ func init() {
 		"pallas.svg":    position.Pallas,
 		"juno.svg":      position.Juno,
 		"vesta.svg":     position.Vesta,
+		"chiron.svg":    position.Chiron,
+		"lilith.svg":    position.Lilith,
+		"fortune.svg":   position.PartOfFortune,
+		"earth.svg":     position.Earth,
 	}
 
 	for filename, sign := range zodiacFiles {
@@ -1,8 +1,18 @@
 package render
 
 import (
+	"bytes"
+	"encoding/hex"
+	"image/color"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/cancelreader"
 	"golang.org/x/term"
 )
 
@@ -32,11 +42,28 @@ const MinHeight = 30
 type TerminalCapabilities struct {
 	KittyGraphics bool
 	Sixel         bool
+	ITerm2        bool // iTerm2 inline images (OSC 1337)
 	TrueColor     bool
+
+	// Name is the terminal name and version reported by XTVERSION or
+	// XTGETTCAP, if it answered
+	Name string
+	// Size of a cell in pixels, 0 when unknown
+	CellWidth  int
+	CellHeight int
+	// Background is the default background colour, nil when unknown
+	Background color.Color
 }
 
-// GetTerminalCapabilities detects terminal capabilities
+// GetTerminalCapabilities detects terminal capabilities from the
+// environment and from the answers of the terminal to queries. The
+// terminal is only queried on the first call, which must happen before
+// the interface starts reading the input.
 func GetTerminalCapabilities() TerminalCapabilities {
+	return detectedCapabilities()
+}
+
+var detectedCapabilities = sync.OnceValue(func() TerminalCapabilities {
 	caps := TerminalCapabilities{}
 
 	// Check for Kitty graphics protocol support (Kitty, Ghostty, WezTerm)
@@ -51,17 +78,155 @@ func GetTerminalCapabilities() TerminalCapabilities {
 		caps.KittyGraphics = true
 	}
 
+	// iTerm2 and WezTerm display inline images; LC_TERMINAL survives ssh
+	if termProgram == "iTerm.app" || termProgram == "WezTerm" || os.Getenv("LC_TERMINAL") == "iTerm2" {
+		caps.ITerm2 = true
+	}
+
 	// Check for true color support
 	colorTerm := os.Getenv("COLORTERM")
 	if colorTerm == "truecolor" || colorTerm == "24bit" {
 		caps.TrueColor = true
 	}
 
+	if reply, ok := queryTerminal(); ok {
+		caps.merge(parseReply(reply))
+	}
 	return caps
+})
+
+// terminalQueries are sent to the terminal, ending with DA1 which every
+// terminal answers: the answers come in order, so the DA1 reply means
+// that the terminal ignored whatever query was left unanswered.
+var terminalQueries = ansi.RequestNameVersion +
+	ansi.XTGETTCAP("TN") +
+	// A Kitty graphics query for a 1×1 image, which is not stored
+	"\x1b_Gi=31,s=1,v=1,a=q,t=d,f=24;AAAA\x1b\\" +
+	ansi.WindowOp(ansi.RequestCellSizeWinOp) +
+	ansi.RequestBackgroundColor +
+	ansi.RequestPrimaryDeviceAttributes
+
+// queryTimeout bounds the wait for a terminal that does not even answer DA1
+const queryTimeout = time.Second
+
+var da1Reply = regexp.MustCompile(`\x1b\[\?([0-9;]*)c`)
+
+// queryTerminal sends the queries and returns the raw answers of the
+// terminal, reading them from the input in raw mode
+func queryTerminal() ([]byte, bool) {
+	in, out := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !term.IsTerminal(in) || !term.IsTerminal(out) {
+		return nil, false
+	}
+	state, err := term.MakeRaw(in)
+	if err != nil {
+		return nil, false
+	}
+	defer func() { _ = term.Restore(in, state) }()
+
+	// The reader is cancelled on timeout, so that no read is left pending
+	// on the input of the interface
+	r, err := cancelreader.NewReader(os.Stdin)
+	if err != nil {
+		return nil, false
+	}
+	defer func() { _ = r.Close() }()
+
+	if _, err := os.Stdout.WriteString(terminalQueries); err != nil {
+		return nil, false
+	}
+
+	// Buffered so that a read that cannot be cancelled never blocks the
+	// reader once it returns
+	done := make(chan []byte, 1)
+	go func() {
+		var reply []byte
+		buf := make([]byte, 256)
+		for !da1Reply.Match(reply) {
+			n, err := r.Read(buf)
+			reply = append(reply, buf[:n]...)
+			if err != nil {
+				break
+			}
+		}
+		done <- reply
+	}()
+
+	select {
+	case reply := <-done:
+		return reply, true
+	case <-time.After(queryTimeout):
+		if !r.Cancel() {
+			return nil, false
+		}
+		return <-done, true
+	}
+}
+
+var (
+	kittyReply      = regexp.MustCompile(`\x1b_Gi=31;OK`)
+	nameReply       = regexp.MustCompile(`\x1bP>\|([^\x1b]*)\x1b\\`)
+	termcapReply    = regexp.MustCompile(`\x1bP1\+r([0-9A-Fa-f]+)=([0-9A-Fa-f]*)\x1b\\`)
+	cellSizeReply   = regexp.MustCompile(`\x1b\[6;(\d+);(\d+)t`)
+	backgroundReply = regexp.MustCompile(`\x1b\]11;rgb:([0-9A-Fa-f]+)/([0-9A-Fa-f]+)/([0-9A-Fa-f]+)`)
+)
+
+// da1Sixel is the DA1 attribute announcing sixel graphics
+const da1Sixel = "4"
+
+// parseReply reads the capabilities out of the answers of the terminal
+func parseReply(reply []byte) TerminalCapabilities {
+	var caps TerminalCapabilities
+	if m := da1Reply.FindSubmatch(reply); m != nil {
+		for attr := range strings.SplitSeq(string(m[1]), ";") {
+			if attr == da1Sixel {
+				caps.Sixel = true
+			}
+		}
+	}
+	caps.KittyGraphics = kittyReply.Match(reply)
+
+	if m := termcapReply.FindSubmatch(reply); m != nil {
+		if name, err := hex.DecodeString(string(m[2])); err == nil {
+			caps.Name = string(name)
+		}
+	}
+	// XTVERSION gives the version too, so it is preferred to the name
+	if m := nameReply.FindSubmatch(reply); m != nil && len(m[1]) > 0 {
+		caps.Name = string(m[1])
+	}
+	if strings.HasPrefix(caps.Name, "iTerm2") || strings.HasPrefix(caps.Name, "WezTerm") {
+		caps.ITerm2 = true
+	}
+
+	if m := cellSizeReply.FindSubmatch(reply); m != nil {
+		caps.CellHeight, _ = strconv.Atoi(string(m[1]))
+		caps.CellWidth, _ = strconv.Atoi(string(m[2]))
+	}
+	if m := backgroundReply.FindSubmatch(reply); m != nil {
+		caps.Background = color.RGBA{R: channel(m[1]), G: channel(m[2]), B: channel(m[3]), A: 0xff}
+	}
+	return caps
+}
+
+// channel reads a colour channel of 1 to 4 hexadecimal digits, as X11
+// colour specifications have
+func channel(digits []byte) uint8 {
+	v, err := strconv.ParseUint(string(bytes.ToLower(digits)), 16, 32)
+	if err != nil {
+		return 0
+	}
+	maxValue := uint64(1)<<(4*len(digits)) - 1
+	return uint8(v * 0xff / maxValue)
 }
 
-// CanDisplayImages returns true if the terminal can display images
-func CanDisplayImages() bool {
-	caps := GetTerminalCapabilities()
-	return caps.KittyGraphics && HasResvg()
+// merge adds the capabilities found by querying the terminal to those
+// guessed from the environment
+func (c *TerminalCapabilities) merge(q TerminalCapabilities) {
+	c.KittyGraphics = c.KittyGraphics || q.KittyGraphics
+	c.Sixel = c.Sixel || q.Sixel
+	c.ITerm2 = c.ITerm2 || q.ITerm2
+	c.Name = q.Name
+	c.CellWidth, c.CellHeight = q.CellWidth, q.CellHeight
+	c.Background = q.Background
 }
@@ -0,0 +1,385 @@
+package render
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// TextWheelGenerator draws zodiac wheels with characters, for terminals
+// without graphics: circles and lines are drawn with braille dots, signs
+// and bodies with their symbols.
+type TextWheelGenerator struct {
+	cols     int
+	rows     int
+	patterns []horoscope.Pattern
+}
+
+// NewTextWheelGenerator creates a generator drawing in cols×rows cells.
+func NewTextWheelGenerator(cols, rows int) *TextWheelGenerator {
+	return &TextWheelGenerator{cols: max(cols, 1), rows: max(rows, 1)}
+}
+
+// SetPatterns sets the natal aspect patterns to highlight.
+func (g *TextWheelGenerator) SetPatterns(patterns []horoscope.Pattern) *TextWheelGenerator {
+	g.patterns = patterns
+	return g
+}
+
+// Generate draws a zodiac wheel (natal only) and returns its rows.
+func (g *TextWheelGenerator) Generate(positions []position.Position) []string {
+	return g.GenerateWithTransits(positions, nil)
+}
+
+// Ring radii, as fractions of the radius of the wheel. Transits get a
+// ring of their own between the inner circle and the signs, as there is
+// no room to share the natal one.
+const (
+	textSignRing    = 0.8
+	textInnerCircle = 0.6
+	textSigns       = 0.9
+	textTransits    = 0.7
+	textNatal       = 0.45
+	textPatterns    = 0.3
+)
+
+// GenerateWithTransits draws a zodiac wheel with natal and transit
+// positions and returns its rows.
+func (g *TextWheelGenerator) GenerateWithTransits(natal, transits []position.Position) []string {
+	c := newBrailleCanvas(g.cols, g.rows)
+
+	c.circle(c.radius, svgPrimary)
+	c.circle(c.radius*textSignRing, svgPrimary)
+	c.circle(c.radius*textInnerCircle, svgPrimary)
+	for lon := 0.0; lon < 360; lon += 30 {
+		c.radialLine(c.radius*textSignRing, c.radius, lon, svgBorder)
+	}
+	g.drawAxes(c)
+	g.drawPatterns(c, natal)
+	c.dot(c.cx, c.cy, svgBright)
+
+	for sign := horoscope.Aries; sign <= horoscope.Pisces; sign++ {
+		x, y := c.polar(c.radius*textSigns, float64(sign)*30+15)
+		c.place(sign.Symbol(), getElementColor(sign.Element()), x, y, c.radius*textSignRing, c.radius, true)
+	}
+
+	// Axis labels come before the bodies, which move aside for them
+	top, bottom := c.radius*textInnerCircle, c.radius*textSignRing
+	c.place("MC", svgTextLight, c.cx, c.cy-top-3, top, bottom, false)
+	c.place("IC", svgTextLight, c.cx, c.cy+top+3, top, bottom, false)
+	c.place("ASC", svgTextLight, c.cx+top+6, c.cy, top, bottom, false)
+	c.place("DSC", svgTextLight, c.cx-top-6, c.cy, top, bottom, false)
+
+	// Ticks mark the exact longitudes, which symbols moved aside to make
+	// room for each other no longer show
+	for _, pos := range natal {
+		c.radialLine(c.radius*(textInnerCircle-0.05), c.radius*textInnerCircle, pos.EclipticLongitude, getPlanetSVGColor(pos.Body))
+	}
+	for _, pos := range transits {
+		c.radialLine(c.radius*(textSignRing-0.04), c.radius*textSignRing, pos.EclipticLongitude, svgAccent)
+	}
+	g.drawBodies(c, natal, c.radius*textNatal, c.radius*textPatterns*0.5, c.radius*(textInnerCircle-0.06), false)
+	g.drawBodies(c, transits, c.radius*textTransits, c.radius*textInnerCircle, c.radius*textSignRing, true)
+
+	return c.lines()
+}
+
+// drawAxes draws the axes of the wheel as dashed lines
+func (g *TextWheelGenerator) drawAxes(c *brailleCanvas) {
+	r := c.radius * textInnerCircle
+	c.dashedLine(c.cx, c.cy-r, c.cx, c.cy+r, svgPurple)
+	c.dashedLine(c.cx-r, c.cy, c.cx+r, c.cy, svgPurple)
+}
+
+// drawPatterns highlights aspect patterns as polygons and stellia as arcs
+// over the inner circle
+func (g *TextWheelGenerator) drawPatterns(c *brailleCanvas, natal []position.Position) {
+	longitudes := make(map[position.CelestialBody]float64)
+	for _, pos := range natal {
+		longitudes[pos.Body] = pos.EclipticLongitude
+	}
+
+	for _, p := range g.patterns {
+		color := getPatternColor(p.Type)
+
+		if p.Type == horoscope.StelliumSign || p.Type == horoscope.StelliumHouse {
+			start, end := stelliumArc(p, longitudes)
+			span := math.Mod(end-start+360, 360)
+			c.arc(c.radius*textInnerCircle, start, span, color)
+			continue
+		}
+
+		var xs, ys []float64
+		for _, body := range p.Bodies {
+			if lon, ok := longitudes[body]; ok {
+				x, y := c.polar(c.radius*textPatterns, lon)
+				xs = append(xs, x)
+				ys = append(ys, y)
+			}
+		}
+		if len(xs) < 3 {
+			continue
+		}
+		for i := range xs {
+			j := (i + 1) % len(xs)
+			c.line(xs[i], ys[i], xs[j], ys[j], color)
+		}
+	}
+}
+
+// drawBodies places the symbols of bodies around a ring, between the
+// radii inner and outer when they have to move aside
+func (g *TextWheelGenerator) drawBodies(c *brailleCanvas, positions []position.Position, radius, inner, outer float64, isTransit bool) {
+	sorted := make([]position.Position, len(positions))
+	copy(sorted, positions)
+	// Major bodies are placed first and keep the best spots
+	sort.SliceStable(sorted, func(i, j int) bool {
+		mi, mj := isMinorBody(sorted[i].Body), isMinorBody(sorted[j].Body)
+		if mi != mj {
+			return mj
+		}
+		return sorted[i].EclipticLongitude < sorted[j].EclipticLongitude
+	})
+
+	for _, pos := range sorted {
+		color := getPlanetSVGColor(pos.Body)
+		if isTransit {
+			color = svgAccent
+		}
+		x, y := c.polar(radius, pos.EclipticLongitude)
+		c.place(pos.Body.Symbol(), color, x, y, inner, outer, true)
+	}
+}
+
+// brailleCanvas is a grid of cells, each holding either 2×4 braille dots
+// or a piece of text. Coordinates are in dots, which are about square.
+type brailleCanvas struct {
+	cols, rows int
+	cells      []textCell
+	cx, cy     float64 // Centre of the wheel
+	radius     float64
+}
+
+// textCell is a cell of a brailleCanvas
+type textCell struct {
+	dots  rune   // Braille dot bits
+	text  string // Text covering the cell, drawn instead of the dots
+	cont  bool   // Covered by the wide text of the previous cell
+	color string
+}
+
+// Dots of a braille cell are 2 wide and 4 high
+const (
+	dotsPerCol = 2
+	dotsPerRow = 4
+)
+
+// brailleBits are the bits of the dots of a braille cell, by row and column
+var brailleBits = [dotsPerRow][dotsPerCol]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+func newBrailleCanvas(cols, rows int) *brailleCanvas {
+	w, h := float64(cols*dotsPerCol), float64(rows*dotsPerRow)
+	return &brailleCanvas{
+		cols:   cols,
+		rows:   rows,
+		cells:  make([]textCell, cols*rows),
+		cx:     w / 2,
+		cy:     h / 2,
+		radius: max(min(w, h)/2-1, 1),
+	}
+}
+
+// polar converts a radius and ecliptic longitude to dot coordinates
+func (c *brailleCanvas) polar(radius, longitude float64) (float64, float64) {
+	angle := (90 - longitude) * math.Pi / 180
+	return c.cx + radius*math.Cos(angle), c.cy - radius*math.Sin(angle)
+}
+
+// cell returns the cell holding the dot at x, y, or nil outside the canvas
+func (c *brailleCanvas) cell(x, y int) *textCell {
+	col, row := x/dotsPerCol, y/dotsPerRow
+	if x < 0 || y < 0 || col >= c.cols || row >= c.rows {
+		return nil
+	}
+	return &c.cells[row*c.cols+col]
+}
+
+// dot sets a dot, the cell taking its colour
+func (c *brailleCanvas) dot(x, y float64, color string) {
+	ix, iy := int(math.Floor(x)), int(math.Floor(y))
+	cell := c.cell(ix, iy)
+	if cell == nil {
+		return
+	}
+	cell.dots |= brailleBits[iy%dotsPerRow][ix%dotsPerCol]
+	cell.color = color
+}
+
+// circle draws a circle around the centre
+func (c *brailleCanvas) circle(radius float64, color string) {
+	c.arc(radius, 0, 360, color)
+}
+
+// arc draws an arc around the centre, from a longitude over span degrees
+func (c *brailleCanvas) arc(radius, start, span float64, color string) {
+	// Steps of half a dot leave no gaps
+	steps := int(math.Ceil(2*math.Pi*radius*span/360*2)) + 1
+	for i := range steps {
+		x, y := c.polar(radius, start+span*float64(i)/float64(max(steps-1, 1)))
+		c.dot(x, y, color)
+	}
+}
+
+// line draws a straight line
+func (c *brailleCanvas) line(x1, y1, x2, y2 float64, color string) {
+	c.pattern(x1, y1, x2, y2, color, func(int) bool { return true })
+}
+
+// dashedLine draws a line with dashes of 3 dots and gaps of 2
+func (c *brailleCanvas) dashedLine(x1, y1, x2, y2 float64, color string) {
+	c.pattern(x1, y1, x2, y2, color, func(i int) bool { return i%5 < 3 })
+}
+
+// pattern draws the dots of a line for which on returns true
+func (c *brailleCanvas) pattern(x1, y1, x2, y2 float64, color string, on func(int) bool) {
+	steps := int(math.Ceil(max(math.Abs(x2-x1), math.Abs(y2-y1))))
+	for i := 0; i <= steps; i++ {
+		if !on(i) {
+			continue
+		}
+		t := float64(i) / float64(max(steps, 1))
+		c.dot(x1+(x2-x1)*t, y1+(y2-y1)*t, color)
+	}
+}
+
+// radialLine draws a line along a longitude, between two radii
+func (c *brailleCanvas) radialLine(from, to, longitude float64, color string) {
+	x1, y1 := c.polar(from, longitude)
+	x2, y2 := c.polar(to, longitude)
+	c.line(x1, y1, x2, y2, color)
+}
+
+// place writes text centred as close as possible to the dot at x, y,
+// keeping its centre between the radii inner and outer and leaving other
+// text alone. A free cell is kept on each side of the text, unless there
+// is no other room and tight is true. It returns false when there is no
+// room.
+func (c *brailleCanvas) place(text, color string, x, y, inner, outer float64, tight bool) bool {
+	width := ansi.StringWidth(text)
+	if width == 0 {
+		return false
+	}
+	type spot struct {
+		col, row int
+		distance float64
+	}
+	var spots []spot
+	const reach = 6 // Cells a text may move in each direction
+	col0, row0 := int(x)/dotsPerCol, int(y)/dotsPerRow
+	for row := row0 - reach; row <= row0+reach; row++ {
+		for col := col0 - reach - width/2; col <= col0+reach; col++ {
+			// Centre of the text, in dots
+			tx := float64(col*dotsPerCol) + float64(width*dotsPerCol)/2
+			ty := float64(row*dotsPerRow) + dotsPerRow/2
+			if r := math.Hypot(tx-c.cx, ty-c.cy); r < inner || r > outer {
+				continue
+			}
+			spots = append(spots, spot{col, row, math.Hypot(tx-x, ty-y)})
+		}
+	}
+	sort.SliceStable(spots, func(i, j int) bool { return spots[i].distance < spots[j].distance })
+
+	for _, gap := range []bool{true, false} {
+		if !gap && !tight {
+			break
+		}
+		for _, s := range spots {
+			if c.free(s.col, s.row, width, gap) {
+				c.write(s.col, s.row, text, width, color)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// free reports whether text of a width can be written at col, row, with a
+// free cell before and after it if gap is true
+func (c *brailleCanvas) free(col, row, width int, gap bool) bool {
+	if col < 0 || row < 0 || col+width > c.cols || row >= c.rows {
+		return false
+	}
+	from, to := col, col+width
+	if gap {
+		from, to = max(col-1, 0), min(col+width+1, c.cols)
+	}
+	for i := from; i < to; i++ {
+		cell := c.cells[row*c.cols+i]
+		if cell.text != "" || cell.cont {
+			return false
+		}
+	}
+	return true
+}
+
+// write writes text over the cells from col, row
+func (c *brailleCanvas) write(col, row int, text string, width int, color string) {
+	cells := c.cells[row*c.cols+col : row*c.cols+col+width]
+	cells[0] = textCell{text: text, color: color}
+	for i := 1; i < width; i++ {
+		cells[i] = textCell{cont: true}
+	}
+}
+
+// lines renders the rows of the canvas, colouring runs of cells of the
+// same colour together
+func (c *brailleCanvas) lines() []string {
+	lines := make([]string, c.rows)
+	for row := range lines {
+		var b, run strings.Builder
+		runColor := ""
+		flush := func() {
+			if run.Len() == 0 {
+				return
+			}
+			if runColor == "" {
+				b.WriteString(run.String())
+			} else {
+				b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color(runColor)).Render(run.String()))
+			}
+			run.Reset()
+		}
+		for _, cell := range c.cells[row*c.cols : (row+1)*c.cols] {
+			if cell.cont {
+				continue
+			}
+			s, color := cell.text, cell.color
+			switch {
+			case s != "":
+			case cell.dots != 0:
+				s = string(0x2800 + cell.dots)
+			default:
+				s, color = " ", ""
+			}
+			if color != runColor {
+				flush()
+				runColor = color
+			}
+			run.WriteString(s)
+		}
+		flush()
+		lines[row] = b.String()
+	}
+	return lines
+}
@@ -0,0 +1,205 @@
+// Package dignities provides the essential dignities table component.
+package dignities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Model is the dignities component state.
+type Model struct {
+	viewport viewport.Model
+	chart    *horoscope.Chart
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new dignities model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the dignities component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the dignities component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.viewport.SetContent(m.buildContent())
+	return m
+}
+
+// SetChart sets the natal chart to analyse.
+func (m Model) SetChart(chart *horoscope.Chart) Model {
+	m.chart = chart
+	if m.width > 0 {
+		m.viewport.SetContent(m.buildContent())
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m Model) buildContent() string {
+	if m.chart == nil {
+		return styles.DimStyle.Render(i18n.T("StatusWaitingNatal"))
+	}
+	return m.buildTable().View() + "\n\n" + m.buildSummary()
+}
+
+// dignityColumns are the dignity types shown as table columns
+var dignityColumns = []struct {
+	dignity horoscope.DignityType
+	key     string
+}{
+	{horoscope.Domicile, "DignityDomicileShort"},
+	{horoscope.Exaltation, "DignityExaltationShort"},
+	{horoscope.Triplicity, "DignityTriplicityShort"},
+	{horoscope.Term, "DignityTermShort"},
+	{horoscope.Face, "DignityFaceShort"},
+	{horoscope.Detriment, "DignityDetrimentShort"},
+	{horoscope.Fall, "DignityFallShort"},
+}
+
+func (m Model) buildTable() table.Model {
+	availableWidth := max(m.width-9, 30)
+	markWidth := 4
+	fixedWidth := 3 + 6 + markWidth*len(dignityColumns)
+	planetWidth := max(availableWidth-fixedWidth-8, 8)
+
+	columns := []table.Column{
+		{Title: "", Width: 3},
+		{Title: i18n.T("PositionPlanet"), Width: planetWidth},
+		{Title: i18n.T("DignitySign"), Width: 8},
+	}
+	for _, col := range dignityColumns {
+		columns = append(columns, table.Column{Title: i18n.T(col.key), Width: markWidth})
+	}
+	columns = append(columns, table.Column{Title: i18n.T("DignityScore"), Width: 6})
+
+	var rows []table.Row
+	for _, d := range m.chart.DignityTable() {
+		row := table.Row{
+			d.Body.Symbol(),
+			d.Body.String(),
+			d.Sign.Symbol() + " " + d.Sign.String()[:3],
+		}
+		for _, col := range dignityColumns {
+			mark := ""
+			if d.Has[col.dignity] {
+				mark = "●"
+			}
+			row = append(row, mark)
+		}
+		row = append(row, fmt.Sprintf("%+d", d.Score))
+		rows = append(rows, row)
+	}
+
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("94")).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.ColorBright)
+	s.Cell = s.Cell.Foreground(styles.ColorTextWarm)
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)+1),
+		table.WithStyles(s),
+	)
+	t.Blur()
+
+	return t
+}
+
+func (m Model) buildSummary() string {
+	var sb strings.Builder
+
+	ruler := "—"
+	if body, ok := m.chart.ChartRuler(); ok {
+		ruler = body.Symbol() + " " + body.String()
+	}
+	sb.WriteString(styles.LabelStyle.Render(i18n.T("DignityChartRuler")+": ") + ruler + "\n")
+
+	final := i18n.T("DignityNone")
+	if body, ok := m.chart.FinalDispositor(); ok {
+		final = body.Symbol() + " " + body.String()
+	}
+	sb.WriteString(styles.LabelStyle.Render(i18n.T("DignityFinalDispositor")+": ") + final + "\n")
+
+	receptions := m.chart.MutualReceptions()
+	sb.WriteString(styles.LabelStyle.Render(i18n.T("DignityReceptions") + ": "))
+	if len(receptions) == 0 {
+		sb.WriteString(i18n.T("DignityNone") + "\n")
+	} else {
+		sb.WriteString("\n")
+		for _, r := range receptions {
+			sb.WriteString(fmt.Sprintf("  %s ⇄ %s (%s)\n", r.Body1.Symbol(), r.Body2.Symbol(), r.Type.String()))
+		}
+	}
+
+	sb.WriteString("\n" + styles.LabelStyle.Render(i18n.T("DignityDispositors")+":") + "\n")
+	for _, body := range position.MainPlanets() {
+		chain := m.chart.DispositorChain(body)
+		symbols := make([]string, len(chain))
+		for i, b := range chain {
+			symbols[i] = b.Symbol()
+		}
+		sb.WriteString("  " + strings.Join(symbols, " → ") + "\n")
+	}
+
+	return sb.String()
+}
+
+// View renders the dignities component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("DignityTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
@@ -0,0 +1,192 @@
+// Package electional provides the electional search results component.
+package electional
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/election"
+)
+
+// dateLayout formats the times of the windows
+const dateLayout = "02/01 15:04"
+
+// Model is the electional search component state.
+type Model struct {
+	viewport  viewport.Model
+	table     table.Model
+	criteria  string
+	windows   []election.Window
+	err       error
+	searching bool
+	search    int // Generation of the running search, older results are dropped
+	width     int
+	height    int
+	focused   bool
+}
+
+// New creates a new electional search model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the electional search component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the electional search component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.refresh()
+	return m
+}
+
+// SetSearching shows that the criteria are being searched for. Empty
+// criteria mean that no search was asked for. Results of earlier searches
+// are ignored from then on.
+func (m Model) SetSearching(criteria string) Model {
+	m.search++
+	m.criteria = criteria
+	m.windows = nil
+	m.err = nil
+	m.searching = criteria != ""
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// Search returns the generation of the running search, to tag its results.
+func (m Model) Search() int {
+	return m.search
+}
+
+// SetResults sets the windows found by a search, or its error, unless a
+// newer search was started since.
+func (m Model) SetResults(search int, windows []election.Window, err error) Model {
+	if search != m.search {
+		return m
+	}
+	m.windows = windows
+	m.err = err
+	m.searching = false
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m *Model) refresh() {
+	switch {
+	case m.criteria == "":
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("ElectionNone")))
+		return
+	case m.searching:
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("ElectionSearching")))
+		return
+	case m.err != nil:
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("ElectionError") + m.err.Error()))
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(styles.DimStyle.Render(m.criteria))
+	sb.WriteString("\n\n")
+	if len(m.windows) == 0 {
+		sb.WriteString(styles.DimStyle.Render(i18n.T("ElectionNoWindow")))
+		m.viewport.SetContent(sb.String())
+		return
+	}
+	m.table = m.buildTable()
+	sb.WriteString(m.table.View())
+	m.viewport.SetContent(sb.String())
+}
+
+func (m Model) buildTable() table.Model {
+	availableWidth := max(m.width-9, 30)
+	scoreWidth := 10
+	dateWidth := (availableWidth - scoreWidth) / 3
+
+	columns := []table.Column{
+		{Title: i18n.T("ElectionStart"), Width: dateWidth},
+		{Title: i18n.T("ElectionEnd"), Width: dateWidth},
+		{Title: i18n.T("ElectionPeak"), Width: dateWidth},
+		{Title: i18n.T("ElectionScore"), Width: scoreWidth},
+	}
+
+	var rows []table.Row
+	for _, w := range m.windows {
+		rows = append(rows, table.Row{
+			w.Start.Local().Format(dateLayout),
+			w.End.Local().Format(dateLayout),
+			w.Peak.Local().Format(dateLayout),
+			fmt.Sprintf("%.0f%%", w.Score*100),
+		})
+	}
+
+	st := table.DefaultStyles()
+	st.Header = st.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("94")).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.ColorBright)
+	st.Cell = st.Cell.Foreground(styles.ColorTextWarm)
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)+1),
+		table.WithStyles(st),
+	)
+	t.Blur()
+
+	return t
+}
+
+// View renders the electional search component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("ElectionTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
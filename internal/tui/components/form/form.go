@@ -3,6 +3,7 @@ package form
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -15,15 +16,20 @@ import (
 	"github.com/ctrl-vfr/astral-tui/internal/i18n"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/messages"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/bazi"
+	"github.com/ctrl-vfr/astral-tui/pkg/election"
 )
 
 // Model is the form component state.
 type Model struct {
 	form                 *huh.Form
 	dateStr              string
+	sex                  string
 	lastValidDate        string
 	transitDateStr       string
 	transitLastValidDate string
+	relocationCity       string
+	electionCriteria     string
 	userContext          string
 	city                 string
 	width                int
@@ -60,6 +66,17 @@ func (m *Model) initForm() {
 				Placeholder(i18n.T("FormBirthDatePlaceholder")).
 				Value(&m.dateStr).
 				Validate(validateDate),
+			huh.NewSelect[string]().
+				Key("sex").
+				Title(i18n.T("FormSex")).
+				Description(i18n.T("FormSexDesc")).
+				Options(
+					huh.NewOption(i18n.T("FormSexUnknown"), ""),
+					huh.NewOption(i18n.T("FormSexFemale"), "female"),
+					huh.NewOption(i18n.T("FormSexMale"), "male"),
+				).
+				Inline(true).
+				Value(&m.sex),
 			huh.NewInput().
 				Key("transit").
 				Title(i18n.T("FormTransitDate")).
@@ -67,6 +84,19 @@ func (m *Model) initForm() {
 				Placeholder(i18n.T("FormTransitDatePlaceholder")).
 				Value(&m.transitDateStr).
 				Validate(validateDate),
+			huh.NewInput().
+				Key("relocation").
+				Title(i18n.T("FormRelocation")).
+				Description(i18n.T("FormRelocationDesc")).
+				Placeholder(i18n.T("FormRelocationPlaceholder")).
+				Value(&m.relocationCity),
+			huh.NewInput().
+				Key("election").
+				Title(i18n.T("FormElection")).
+				Description(i18n.T("FormElectionDesc")).
+				Placeholder(i18n.T("FormElectionPlaceholder")).
+				Value(&m.electionCriteria).
+				Validate(validateElection),
 			huh.NewText().
 				Key("context").
 				Title(i18n.T("FormQuestion")).
@@ -91,6 +121,14 @@ func validateDate(s string) error {
 	return nil
 }
 
+func validateElection(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	_, err := election.Parse(s)
+	return err
+}
+
 // Init initializes the form component.
 func (m Model) Init() tea.Cmd {
 	if m.missingCity {
@@ -146,17 +184,34 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 func (m Model) geocodeCity() tea.Cmd {
 	city := m.city
+	relocation := strings.TrimSpace(m.GetRelocationCity())
 	return func() tea.Msg {
 		geocoder := client.NewGeocodingClient()
 		result, err := geocoder.Search(city)
 		if err != nil {
 			return messages.GeocodingResultMsg{Err: err}
 		}
-		return messages.GeocodingResultMsg{
+		msg := messages.GeocodingResultMsg{
 			Latitude:    result.Latitude,
 			Longitude:   result.Longitude,
 			DisplayName: result.DisplayName,
 		}
+		if relocation == "" {
+			return msg
+		}
+
+		// Nominatim allows one request per second
+		time.Sleep(time.Second)
+		place, err := geocoder.Search(relocation)
+		if err != nil {
+			return messages.GeocodingResultMsg{Err: fmt.Errorf("%s: %w", relocation, err)}
+		}
+		msg.Relocation = &messages.Place{
+			Latitude:    place.Latitude,
+			Longitude:   place.Longitude,
+			DisplayName: place.DisplayName,
+		}
+		return msg
 	}
 }
 
@@ -192,6 +247,15 @@ func (m Model) GetDateTime() (time.Time, error) {
 		now.Hour(), now.Minute(), 0, 0, time.Local), nil
 }
 
+// GetSex returns the sex chosen for the luck pillars, Unknown if none.
+func (m Model) GetSex() bazi.Sex {
+	if m.form == nil {
+		return bazi.Unknown
+	}
+	sex, _ := bazi.ParseSex(m.form.GetString("sex"))
+	return sex
+}
+
 // GetTransitDateTime returns the parsed transit date and time.
 func (m Model) GetTransitDateTime() (time.Time, error) {
 	dateStr := m.transitDateStr
@@ -206,6 +270,22 @@ func (m Model) GetTransitDateTime() (time.Time, error) {
 		12, 0, 0, 0, time.Local), nil
 }
 
+// GetRelocationCity returns the city to relocate the chart to, if any.
+func (m Model) GetRelocationCity() string {
+	if m.form == nil {
+		return ""
+	}
+	return m.form.GetString("relocation")
+}
+
+// GetElectionCriteria returns the electional criteria to search for, if any.
+func (m Model) GetElectionCriteria() string {
+	if m.form == nil {
+		return ""
+	}
+	return strings.TrimSpace(m.form.GetString("election"))
+}
+
 // GetUserContext returns the user's question or context.
 func (m Model) GetUserContext() string {
 	if m.form == nil {
@@ -20,7 +20,23 @@ type Model struct {
 	dateTime time.Time
 	location string
 	hasChart bool
-	elements map[horoscope.Element]int
+	elements map[horoscope.Element]float64 // Weighted points of the chart overview
+
+	// Planetary hour at the chart place, refreshed every second
+	hasPlace  bool
+	latitude  float64
+	longitude float64
+	tick      int // Generation of the running tick, older ticks are dropped
+	now       time.Time
+	day       horoscope.PlanetaryDay
+	hasDay    bool
+	retryAt   time.Time // Next local midnight when the Sun did not rise or set
+}
+
+// TickMsg advances the planetary hour countdown
+type TickMsg struct {
+	tick int
+	time time.Time
 }
 
 // New creates a new header model.
@@ -34,43 +50,77 @@ func (m Model) Init() tea.Cmd {
 }
 
 // Update handles messages for the header component.
-func (m Model) Update(_ tea.Msg) (Model, tea.Cmd) {
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(TickMsg); ok && m.hasPlace && msg.tick == m.tick {
+		m.now = msg.time
+		// A new planetary day starts at sunrise, a day without one is only
+		// retried on the next local date
+		if m.hasDay && !m.now.Before(m.day.NextSunrise) || !m.hasDay && !m.now.Before(m.retryAt) {
+			m = m.refreshDay()
+		}
+		return m, m.nextTick()
+	}
 	return m, nil
 }
 
+// SetPlace sets the place of the planetary hours and starts the countdown.
+func (m Model) SetPlace(latitude, longitude float64) (Model, tea.Cmd) {
+	m.hasPlace = true
+	m.latitude = latitude
+	m.longitude = longitude
+	m.tick++
+	m.now = time.Now()
+	m = m.refreshDay()
+	return m, m.nextTick()
+}
+
+// ClearPlace hides the planetary hour and stops the countdown.
+func (m Model) ClearPlace() Model {
+	m.hasPlace = false
+	m.hasDay = false
+	m.tick++
+	return m
+}
+
+func (m Model) refreshDay() Model {
+	day, err := horoscope.PlanetaryDayAt(m.now, m.latitude, m.longitude)
+	m.day, m.hasDay = day, err == nil
+	if err != nil {
+		local := horoscope.LocalMeanTime(m.now, m.longitude)
+		m.retryAt = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, local.Location())
+	}
+	return m
+}
+
+func (m Model) nextTick() tea.Cmd {
+	tick := m.tick
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return TickMsg{tick: tick, time: t}
+	})
+}
+
 // SetSize sets the header width.
 func (m Model) SetSize(width int) Model {
 	m.width = width
 	return m
 }
 
-// SetPositions updates the element distribution from positions.
+// SetPositions updates the element balance from positions.
 func (m Model) SetPositions(positions []position.Position) Model {
-	m.elements = calculateElements(positions)
+	chart := &horoscope.Chart{Positions: positions}
+	m.elements = chart.Overview().Elements
 	return m
 }
 
 // SetChart updates the header with chart information.
-func (m Model) SetChart(dateTime time.Time, location string, positions []position.Position) Model {
-	m.dateTime = dateTime
-	m.location = location
+func (m Model) SetChart(chart *horoscope.Chart) Model {
+	m.dateTime = chart.DateTime
+	m.location = chart.Location
 	m.hasChart = true
-	m.elements = calculateElements(positions)
+	m.elements = chart.Overview().Elements
 	return m
 }
 
-func calculateElements(positions []position.Position) map[horoscope.Element]int {
-	elements := make(map[horoscope.Element]int)
-	for _, pos := range positions {
-		if pos.Body > position.Pluto {
-			continue
-		}
-		zodiac := horoscope.LongitudeToZodiac(pos.EclipticLongitude)
-		elements[zodiac.Sign.Element()]++
-	}
-	return elements
-}
-
 // View renders the header component.
 func (m Model) View() string {
 	titleStyle := lipgloss.NewStyle().
@@ -95,13 +145,16 @@ func (m Model) View() string {
 		}
 	}
 
-	right := ""
+	right := m.hourView()
 	if m.elements != nil {
-		right = fmt.Sprintf("%s %s %s %s",
-			fireStyle.Render(fmt.Sprintf("🔥%d", m.elements[horoscope.Fire])),
-			earthStyle.Render(fmt.Sprintf("🪨%d", m.elements[horoscope.Earth])),
-			airStyle.Render(fmt.Sprintf("💨%d", m.elements[horoscope.Air])),
-			waterStyle.Render(fmt.Sprintf("💦%d", m.elements[horoscope.Water])))
+		if right != "" {
+			right += "  "
+		}
+		right += fmt.Sprintf("%s %s %s %s",
+			fireStyle.Render(fmt.Sprintf("🔥%g", m.elements[horoscope.Fire])),
+			earthStyle.Render(fmt.Sprintf("🪨%g", m.elements[horoscope.Earth])),
+			airStyle.Render(fmt.Sprintf("💨%g", m.elements[horoscope.Air])),
+			waterStyle.Render(fmt.Sprintf("💦%g", m.elements[horoscope.Water])))
 	}
 
 	width := m.width - 4
@@ -112,6 +165,28 @@ func (m Model) View() string {
 	return leftRightPad(left, right, width)
 }
 
+// hourView shows the day ruler and the current planetary hour with the
+// time left in it
+func (m Model) hourView() string {
+	if !m.hasDay {
+		return ""
+	}
+	hour, ok := m.day.HourAt(m.now)
+	if !ok {
+		return ""
+	}
+	dayStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("223"))
+	hourStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
+
+	left := hour.Remaining(m.now).Round(time.Second)
+	countdown := fmt.Sprintf("%02d:%02d", int(left.Minutes()), int(left.Seconds())%60)
+	if left >= time.Hour {
+		countdown = fmt.Sprintf("%d:%02d:%02d", int(left.Hours()), int(left.Minutes())%60, int(left.Seconds())%60)
+	}
+	return dayStyle.Render(fmt.Sprintf(i18n.T("HeaderPlanetaryDay"), m.day.Ruler.Symbol())+" • ") +
+		hourStyle.Render(fmt.Sprintf(i18n.T("HeaderPlanetaryHour"), hour.Ruler.Symbol()+" "+hour.Ruler.String())+" ⏳"+countdown)
+}
+
 func leftRightPad(left, right string, width int) string {
 	leftLen := lipgloss.Width(left)
 	rightLen := lipgloss.Width(right)
@@ -0,0 +1,162 @@
+// Package helio provides the geocentric/heliocentric comparison component.
+package helio
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Model is the heliocentric comparison component state.
+type Model struct {
+	viewport viewport.Model
+	table    table.Model
+	geo      *horoscope.Chart
+	helio    *horoscope.Chart
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new heliocentric comparison model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the heliocentric comparison component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the heliocentric comparison component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.refresh()
+	return m
+}
+
+// SetCharts sets the geocentric chart and its heliocentric counterpart.
+func (m Model) SetCharts(geo, helio *horoscope.Chart) Model {
+	m.geo = geo
+	m.helio = helio
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m *Model) refresh() {
+	if m.geo == nil || m.helio == nil {
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("StatusWaitingNatal")))
+		return
+	}
+	m.table = m.buildTable()
+	m.viewport.SetContent(m.table.View())
+}
+
+func (m Model) buildTable() table.Model {
+	availableWidth := max(m.width-9, 30)
+	flexWidth := availableWidth - 3
+	planetWidth := flexWidth / 3
+	posWidth := (flexWidth - planetWidth) / 2
+
+	columns := []table.Column{
+		{Title: "", Width: 3},
+		{Title: i18n.T("PositionPlanet"), Width: planetWidth},
+		{Title: i18n.T("HelioGeocentric"), Width: posWidth},
+		{Title: i18n.T("HelioHeliocentric"), Width: posWidth},
+	}
+
+	var rows []table.Row
+	for _, h := range m.helio.Positions {
+		// The Earth is compared with the geocentric Sun, its mirror image
+		geoBody := h.Body
+		if geoBody == position.Earth {
+			geoBody = position.Sun
+		}
+		geo := ""
+		if g := m.geo.GetPosition(geoBody); g != nil {
+			geo = formatLongitude(g.EclipticLongitude)
+			if g.Retrograde {
+				geo += " " + position.RetrogradeSymbol
+			}
+		}
+		rows = append(rows, table.Row{
+			h.Body.Symbol(),
+			h.Body.String(),
+			geo,
+			formatLongitude(h.EclipticLongitude),
+		})
+	}
+
+	st := table.DefaultStyles()
+	st.Header = st.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("94")).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.ColorBright)
+	st.Cell = st.Cell.Foreground(styles.ColorTextWarm)
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)+1),
+		table.WithStyles(st),
+	)
+	t.Blur()
+
+	return t
+}
+
+func formatLongitude(lon float64) string {
+	z := horoscope.LongitudeToZodiac(lon)
+	return fmt.Sprintf("%s %02d°%02d'", z.Sign.Symbol(), z.Degrees, z.Minutes)
+}
+
+// View renders the heliocentric comparison component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("HelioTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
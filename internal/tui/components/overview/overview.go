@@ -0,0 +1,183 @@
+// Package overview provides the chart overview component: element and
+// modality balance, hemisphere emphasis and chart shape.
+package overview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// Model is the chart overview component state.
+type Model struct {
+	viewport viewport.Model
+	chart    *horoscope.Chart
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new chart overview model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the chart overview component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the chart overview component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.refresh()
+	return m
+}
+
+// SetChart sets the chart to analyse.
+func (m Model) SetChart(chart *horoscope.Chart) Model {
+	m.chart = chart
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m *Model) refresh() {
+	if m.chart == nil {
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("StatusWaitingNatal")))
+		return
+	}
+	o := m.chart.Overview()
+
+	var sb strings.Builder
+	sb.WriteString(styles.DimStyle.Render(i18n.T("OverviewBalance")) + "\n\n")
+	sb.WriteString(m.balance(o))
+	sb.WriteString("\n" + sectionStyle().Render(i18n.T("OverviewHemispheres")) + "\n")
+	if o.HasHouses {
+		sb.WriteString(hemispheres(o))
+	} else {
+		sb.WriteString(styles.DimStyle.Render(i18n.T("OverviewNoHouses")) + "\n")
+	}
+	sb.WriteString("\n" + shape(o, m.width-6))
+	m.viewport.SetContent(sb.String())
+}
+
+func sectionStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(styles.ColorBright)
+}
+
+// balance renders the weighted points of the elements and modalities as bars
+func (m Model) balance(o horoscope.Overview) string {
+	total := 0.0
+	for _, points := range o.Elements {
+		total += points
+	}
+	barWidth := max(min(m.width-28, 24), 6)
+	bar := func(points float64, style lipgloss.Style) string {
+		cells := 0
+		if total > 0 {
+			cells = int(points/total*float64(barWidth)*2 + 0.5)
+		}
+		return style.Render(strings.Repeat("█", cells/2)+strings.Repeat("▌", cells%2)) +
+			fmt.Sprintf(" %g", points)
+	}
+	label := lipgloss.NewStyle().Width(11).Foreground(styles.ColorTextWarm)
+	dominant := func(yes bool) string {
+		if yes {
+			return " " + styles.FocusedStyle.Render("★")
+		}
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sectionStyle().Render(i18n.T("OverviewElements")) + "\n")
+	for e := horoscope.Fire; e <= horoscope.Water; e++ {
+		sb.WriteString(label.Render(i18n.T("Element"+e.String())) +
+			bar(o.Elements[e], styles.ElementStyle(e)) + dominant(e == o.Element) + "\n")
+	}
+	sb.WriteString("\n" + sectionStyle().Render(i18n.T("OverviewModalities")) + "\n")
+	for md := horoscope.Cardinal; md <= horoscope.Mutable; md++ {
+		sb.WriteString(label.Render(i18n.T("Modality"+md.String())) +
+			bar(o.Modalities[md], styles.LabelStyle) + dominant(md == o.Modality) + "\n")
+	}
+	return sb.String()
+}
+
+// hemispheres renders the planets per hemisphere and the quadrants laid out
+// as on the wheel: the Ascendant on the left, the Midheaven at the top
+func hemispheres(o horoscope.Overview) string {
+	label := styles.LabelStyle
+	cell := lipgloss.NewStyle().Width(8).Align(lipgloss.Center)
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %d  %s %d\n", label.Render(i18n.T("OverviewEastern")), o.Eastern,
+		label.Render(i18n.T("OverviewWestern")), o.Western))
+	sb.WriteString(fmt.Sprintf("%s %d  %s %d\n\n", label.Render(i18n.T("OverviewSouthern")), o.Southern,
+		label.Render(i18n.T("OverviewNorthern")), o.Northern))
+	sb.WriteString(styles.DimStyle.Render(i18n.T("OverviewQuadrants")) + "\n")
+	sb.WriteString(cell.Render(fmt.Sprintf("IV %d", o.Quadrants[3])) + "│" + cell.Render(fmt.Sprintf("III %d", o.Quadrants[2])) + "\n")
+	sb.WriteString(strings.Repeat("─", 8) + "┼" + strings.Repeat("─", 8) + "\n")
+	sb.WriteString(cell.Render(fmt.Sprintf("I %d", o.Quadrants[0])) + "│" + cell.Render(fmt.Sprintf("II %d", o.Quadrants[1])) + "\n")
+	return sb.String()
+}
+
+// shape renders the Jones shape with its leading planet and meaning
+func shape(o horoscope.Overview, width int) string {
+	name := "Shape" + o.Shape.String()
+	line := sectionStyle().Render(i18n.T("OverviewShape")) + "  " + styles.FocusedStyle.Render(i18n.T(name))
+	if o.HasLeader {
+		role := i18n.T("OverviewLeader")
+		if o.Shape == horoscope.Bucket {
+			role = i18n.T("OverviewHandle")
+		}
+		line += styles.DimStyle.Render(fmt.Sprintf("  %s: ", role)) + styles.StylePlanet(o.Leader) + " " + o.Leader.String()
+	}
+	desc := lipgloss.NewStyle().Width(max(width, 20)).Foreground(styles.ColorTextWarm).Render(i18n.T(name + "Desc"))
+	return line + "\n" + desc + "\n"
+}
+
+// View renders the chart overview component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("OverviewTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
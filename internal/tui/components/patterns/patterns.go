@@ -0,0 +1,142 @@
+// Package patterns provides the aspect patterns list component.
+package patterns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Model is the patterns component state.
+type Model struct {
+	viewport viewport.Model
+	patterns []horoscope.Pattern
+	hasChart bool
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new patterns model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the patterns component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the patterns component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.viewport.SetContent(m.buildContent())
+	return m
+}
+
+// SetChart sets the natal chart whose patterns are listed.
+func (m Model) SetChart(chart *horoscope.Chart) Model {
+	m.patterns = chart.Patterns()
+	m.hasChart = true
+	if m.width > 0 {
+		m.viewport.SetContent(m.buildContent())
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m Model) buildContent() string {
+	if !m.hasChart {
+		return styles.DimStyle.Render(i18n.T("StatusWaitingNatal"))
+	}
+	if len(m.patterns) == 0 {
+		return styles.DimStyle.Render(i18n.T("PatternNone"))
+	}
+
+	var sb strings.Builder
+	for _, p := range m.patterns {
+		nameStyle := styles.TenseStyle
+		if p.Type.IsHarmonic() {
+			nameStyle = styles.HarmonicStyle
+		}
+		if p.Type == horoscope.StelliumSign || p.Type == horoscope.StelliumHouse {
+			nameStyle = styles.NeutralStyle
+		}
+
+		sb.WriteString(nameStyle.Bold(true).Render(p.Type.String()))
+		switch p.Type {
+		case horoscope.StelliumSign:
+			sb.WriteString(styles.DimStyle.Render(" " + p.Sign.Symbol() + " " + p.Sign.String()))
+		case horoscope.StelliumHouse:
+			sb.WriteString(styles.DimStyle.Render(fmt.Sprintf(" %s %d", i18n.T("PatternHouse"), p.House)))
+		}
+		sb.WriteString("\n  " + bodyList(p.Bodies))
+		if p.HasApex {
+			sb.WriteString(styles.LabelStyle.Render(fmt.Sprintf("  %s %s %s", i18n.T("PatternApex"), p.Apex.Symbol(), p.Apex.String())))
+		}
+		sb.WriteString(styles.DimStyle.Render(fmt.Sprintf("  %s %.1f°", tightnessLabel(p.Type), p.Tightness)))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func bodyList(bodies []position.CelestialBody) string {
+	names := make([]string, len(bodies))
+	for i, b := range bodies {
+		names[i] = styles.StylePlanet(b) + " " + b.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func tightnessLabel(t horoscope.PatternType) string {
+	if t == horoscope.StelliumSign || t == horoscope.StelliumHouse {
+		return i18n.T("PatternSpan")
+	}
+	return i18n.T("PromptOrb")
+}
+
+// View renders the patterns component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("PatternTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
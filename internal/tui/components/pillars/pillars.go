@@ -0,0 +1,245 @@
+// Package pillars provides the BaZi Four Pillars component.
+package pillars
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/bazi"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// Model is the Four Pillars component state.
+type Model struct {
+	viewport viewport.Model
+	table    table.Model
+	chart    *bazi.Chart
+	sex      bazi.Sex
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new Four Pillars model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the Four Pillars component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the Four Pillars component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.refresh()
+	return m
+}
+
+// SetChart computes the pillars of the birth of a chart; the sex gives the
+// direction of the luck pillars.
+func (m Model) SetChart(chart *horoscope.Chart, sex bazi.Sex) Model {
+	c := bazi.Calculate(chart.DateTime, chart.Longitude)
+	m.chart = &c
+	m.sex = sex
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// Export returns the serializable pillars, if any.
+func (m Model) Export() (bazi.Export, bool) {
+	if m.chart == nil {
+		return bazi.Export{}, false
+	}
+	return bazi.NewExport(*m.chart, m.sex), true
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+// phaseColors are the traditional colours of the five phases
+var phaseColors = map[bazi.Element]lipgloss.Color{
+	bazi.Wood:  lipgloss.Color("34"),
+	bazi.Fire:  styles.ColorSecondary,
+	bazi.Earth: lipgloss.Color("136"),
+	bazi.Metal: lipgloss.Color("250"),
+	bazi.Water: lipgloss.Color("33"),
+}
+
+func phase(e bazi.Element) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(phaseColors[e])
+}
+
+func (m *Model) refresh() {
+	if m.chart == nil {
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("StatusWaitingNatal")))
+		return
+	}
+	c := m.chart
+	dm := c.DayMaster()
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s  %s %s\n",
+		phase(c.Year.Stem.Element()).Render(fmt.Sprintf("%s %s", c.Year.Stem.Polarity(), i18n.T("BaZiElement"+c.Year.Stem.Element().String()))),
+		lipgloss.NewStyle().Bold(true).Foreground(styles.ColorBright).Render(i18n.T("BaZiAnimal"+c.Animal())),
+		styles.DimStyle.Render(i18n.T("BaZiDayMaster")+":"),
+		phase(dm.Element()).Render(fmt.Sprintf("%s %s %s %s", dm.Hanzi(), dm, dm.Polarity(), i18n.T("BaZiElement"+dm.Element().String())))))
+	sb.WriteString(styles.DimStyle.Render(fmt.Sprintf("%s %s", i18n.T("BaZiSolarTime"), c.SolarTime.Format("02/01/2006 15:04"))) + "\n\n")
+	sb.WriteString(m.grid())
+	sb.WriteString(styles.DimStyle.Render(i18n.T("BaZiNoTime")) + "\n\n")
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(styles.ColorBright)
+	if m.sex == bazi.Unknown {
+		sb.WriteString(title.Render(i18n.T("BaZiLuck")) + "\n")
+		sb.WriteString(styles.DimStyle.Render(i18n.T("BaZiNoSex")))
+		m.viewport.SetContent(sb.String())
+		return
+	}
+	direction := i18n.T("BaZiForward")
+	if !c.Forward(m.sex) {
+		direction = i18n.T("BaZiBackward")
+	}
+	sb.WriteString(title.Render(i18n.T("BaZiLuck")) + styles.DimStyle.Render(" ("+direction+")") + "\n")
+	m.table = m.buildTable()
+	sb.WriteString(m.table.View())
+	m.viewport.SetContent(sb.String())
+}
+
+// grid lays the pillars out as columns, hour to year from left to right as
+// they are traditionally written
+func (m Model) grid() string {
+	c := m.chart
+	names := []string{i18n.T("BaZiHour"), i18n.T("BaZiDay"), i18n.T("BaZiMonth"), i18n.T("BaZiYear")}
+	pillars := []bazi.Pillar{c.Hour, c.Day, c.Month, c.Year}
+
+	label := lipgloss.NewStyle().Width(9).Foreground(styles.ColorTextWarm)
+	cell := lipgloss.NewStyle().Width(10)
+	row := func(title string, f func(bazi.Pillar) string) string {
+		var sb strings.Builder
+		sb.WriteString(label.Render(title))
+		for _, p := range pillars {
+			sb.WriteString(cell.Render(f(p)))
+		}
+		return sb.String() + "\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(label.Render(""))
+	for _, name := range names {
+		sb.WriteString(cell.Render(lipgloss.NewStyle().Bold(true).Foreground(styles.ColorBright).Render(name)))
+	}
+	sb.WriteString("\n")
+	sb.WriteString(row(i18n.T("BaZiStem"), func(p bazi.Pillar) string {
+		return phase(p.Stem.Element()).Bold(true).Render(p.Stem.Hanzi() + " " + p.Stem.String())
+	}))
+	sb.WriteString(row("", func(p bazi.Pillar) string {
+		sign := "+"
+		if !p.Stem.Yang() {
+			sign = "-"
+		}
+		return phase(p.Stem.Element()).Render(sign + i18n.T("BaZiElement"+p.Stem.Element().String()))
+	}))
+	sb.WriteString(row(i18n.T("BaZiBranch"), func(p bazi.Pillar) string {
+		return phase(p.Branch.Element()).Bold(true).Render(p.Branch.Hanzi() + " " + p.Branch.String())
+	}))
+	sb.WriteString(row("", func(p bazi.Pillar) string {
+		return phase(p.Branch.Element()).Render(i18n.T("BaZiAnimal" + p.Branch.Animal()))
+	}))
+	return sb.String() + "\n"
+}
+
+func (m Model) buildTable() table.Model {
+	availableWidth := max(m.width-9, 30)
+	ageWidth := 6
+	pillarWidth := 12
+	animalWidth := 10
+	periodWidth := max(availableWidth-ageWidth-pillarWidth-animalWidth, 15)
+
+	columns := []table.Column{
+		{Title: i18n.T("BaZiAge"), Width: ageWidth},
+		{Title: "", Width: pillarWidth},
+		{Title: "", Width: animalWidth},
+		{Title: i18n.T("BaZiPeriod"), Width: periodWidth},
+	}
+
+	now := time.Now()
+	var rows []table.Row
+	for _, l := range m.chart.LuckPillars(m.sex, bazi.LuckPillarCount) {
+		period := l.Start.Format("01/2006") + " – " + l.End.Format("01/2006")
+		if !now.Before(l.Start) && now.Before(l.End) {
+			period += " ←"
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%.1f", l.Age),
+			l.Hanzi() + " " + l.Pillar.String(),
+			i18n.T("BaZiAnimal" + l.Branch.Animal()),
+			period,
+		})
+	}
+
+	st := table.DefaultStyles()
+	st.Header = st.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("94")).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.ColorBright)
+	st.Cell = st.Cell.Foreground(styles.ColorTextWarm)
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)+1),
+		table.WithStyles(st),
+	)
+	t.Blur()
+
+	return t
+}
+
+// View renders the Four Pillars component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("BaZiTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
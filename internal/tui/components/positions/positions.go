@@ -3,6 +3,7 @@ package positions
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -102,7 +103,7 @@ func (m Model) buildTable() table.Model {
 	availableWidth = max(availableWidth, 30)
 
 	if m.chart != nil {
-		fixedWidth := 12
+		fixedWidth := 12 + declinationWidth + speedWidth
 		flexWidth := availableWidth - fixedWidth
 		planetWidth := flexWidth / 4
 		posWidth := (flexWidth - planetWidth) / 2
@@ -112,12 +113,14 @@ func (m Model) buildTable() table.Model {
 			{Title: i18n.T("PositionPlanet"), Width: planetWidth},
 			{Title: i18n.T("PositionNatal"), Width: posWidth},
 			{Title: "℞", Width: 3},
+			{Title: i18n.T("PositionDeclination"), Width: declinationWidth},
+			{Title: i18n.T("PositionSpeed"), Width: speedWidth},
 			{Title: i18n.T("PositionTransit"), Width: posWidth},
 			{Title: "℞", Width: 3},
 		}
 		rows = m.buildCombinedRows()
 	} else {
-		fixedWidth := 9
+		fixedWidth := 9 + declinationWidth + speedWidth
 		flexWidth := availableWidth - fixedWidth
 		planetWidth := flexWidth / 3
 		posWidth := flexWidth - planetWidth
@@ -127,6 +130,8 @@ func (m Model) buildTable() table.Model {
 			{Title: i18n.T("PositionPlanet"), Width: planetWidth},
 			{Title: i18n.T("PositionPosition"), Width: posWidth},
 			{Title: "℞", Width: 3},
+			{Title: i18n.T("PositionDeclination"), Width: declinationWidth},
+			{Title: i18n.T("PositionSpeed"), Width: speedWidth},
 		}
 		rows = m.buildTransitRows()
 	}
@@ -164,6 +169,8 @@ func (m Model) buildTransitRows() []table.Row {
 			pos.Body.String(),
 			fmt.Sprintf("%s %02d°%02d'", zodiac.Sign.Symbol(), zodiac.Degrees, zodiac.Minutes),
 			retro,
+			formatDeclination(pos),
+			formatSpeed(pos),
 		})
 	}
 	return rows
@@ -199,6 +206,8 @@ func (m Model) buildCombinedRows() []table.Row {
 			natal.Body.String(),
 			fmt.Sprintf("%s %02d°%02d'", natalZodiac.Sign.Symbol(), natalZodiac.Degrees, natalZodiac.Minutes),
 			natalRetro,
+			formatDeclination(natal),
+			formatSpeed(natal),
 			transitPos,
 			transitRetro,
 		})
@@ -206,6 +215,49 @@ func (m Model) buildCombinedRows() []table.Row {
 	return rows
 }
 
+// declinationWidth fits a declination with its out-of-bounds marker
+const declinationWidth = 11
+
+// formatDeclination renders a declination as degrees and minutes,
+// flagging bodies out of bounds
+func formatDeclination(pos position.Position) string {
+	sign := "+"
+	if pos.Declination < 0 {
+		sign = "-"
+	}
+	dec := math.Abs(pos.Declination)
+	deg := int(dec)
+	minutes := int((dec - float64(deg)) * 60)
+
+	s := fmt.Sprintf("%s%02d°%02d'", sign, deg, minutes)
+	if pos.IsOutOfBounds() {
+		s += " " + i18n.T("PositionOOB")
+	}
+	return s
+}
+
+// speedWidth fits a daily motion with its speed class
+const speedWidth = 13
+
+// formatSpeed renders the daily motion in degrees with its speed class.
+// Calculated points have no motion and are left blank.
+func formatSpeed(pos position.Position) string {
+	if _, ok := position.MeanDailyMotion(pos.Body); !ok {
+		return ""
+	}
+	return fmt.Sprintf("%+.2f° %s", pos.Speed, speedLabel(pos.SpeedClass()))
+}
+
+func speedLabel(class position.SpeedClass) string {
+	switch class {
+	case position.SpeedFast:
+		return i18n.T("SpeedFast")
+	case position.SpeedStationary:
+		return i18n.T("SpeedStationary")
+	}
+	return i18n.T("SpeedSlow")
+}
+
 // View renders the positions component.
 func (m Model) View() string {
 	borderColor := lipgloss.Color("94")
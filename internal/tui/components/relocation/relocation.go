@@ -0,0 +1,185 @@
+// Package relocation provides the natal/relocated house comparison component.
+package relocation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// Model is the relocation comparison component state.
+type Model struct {
+	viewport  viewport.Model
+	table     table.Model
+	natal     *horoscope.Chart
+	relocated *horoscope.Chart
+	width     int
+	height    int
+	focused   bool
+}
+
+// New creates a new relocation comparison model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the relocation comparison component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the relocation comparison component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.refresh()
+	return m
+}
+
+// SetCharts sets the natal chart and its relocated counterpart, which is
+// nil when no relocation was asked for.
+func (m Model) SetCharts(natal, relocated *horoscope.Chart) Model {
+	m.natal = natal
+	m.relocated = relocated
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m *Model) refresh() {
+	if m.natal == nil {
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("StatusWaitingNatal")))
+		return
+	}
+	if m.relocated == nil || m.natal.Houses == nil || m.relocated.Houses == nil {
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("RelocationNone")))
+		return
+	}
+	m.table = m.buildTable()
+
+	var sb strings.Builder
+	sb.WriteString(m.angles(i18n.T("RelocationNatal"), m.natal))
+	sb.WriteString(m.angles(i18n.T("RelocationRelocated"), m.relocated))
+	sb.WriteString("\n")
+	sb.WriteString(m.table.View())
+	m.viewport.SetContent(sb.String())
+}
+
+// angles renders the place and angles of a chart on one line
+func (m Model) angles(label string, chart *horoscope.Chart) string {
+	name := lipgloss.NewStyle().Bold(true).Foreground(styles.ColorBright).Render(label)
+	return fmt.Sprintf("%s  %s  ASC %s  MC %s\n",
+		name,
+		styles.DimStyle.Render(chart.Location),
+		formatLongitude(chart.Houses.GetAscendant()),
+		formatLongitude(chart.Houses.GetMC()),
+	)
+}
+
+func (m Model) buildTable() table.Model {
+	availableWidth := max(m.width-9, 30)
+	houseWidth := 9
+	flexWidth := availableWidth - 3 - 2*houseWidth
+	planetWidth := flexWidth / 2
+	posWidth := flexWidth - planetWidth
+
+	columns := []table.Column{
+		{Title: "", Width: 3},
+		{Title: i18n.T("PositionPlanet"), Width: planetWidth},
+		{Title: i18n.T("PositionPosition"), Width: posWidth},
+		{Title: i18n.T("RelocationNatal"), Width: houseWidth},
+		{Title: i18n.T("RelocationRelocated"), Width: houseWidth},
+	}
+
+	var rows []table.Row
+	for _, pos := range m.relocated.Positions {
+		natal := m.relocated.NatalHouse(pos.Body)
+		relocated := m.relocated.BodyInHouse(pos.Body)
+		if natal == 0 || relocated == 0 {
+			continue
+		}
+		moved := strconv.Itoa(relocated)
+		if relocated != natal {
+			moved += " ←"
+		}
+		rows = append(rows, table.Row{
+			pos.Body.Symbol(),
+			pos.Body.String(),
+			formatLongitude(pos.EclipticLongitude),
+			strconv.Itoa(natal),
+			moved,
+		})
+	}
+
+	st := table.DefaultStyles()
+	st.Header = st.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("94")).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.ColorBright)
+	st.Cell = st.Cell.Foreground(styles.ColorTextWarm)
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)+1),
+		table.WithStyles(st),
+	)
+	t.Blur()
+
+	return t
+}
+
+func formatLongitude(lon float64) string {
+	z := horoscope.LongitudeToZodiac(lon)
+	return fmt.Sprintf("%s %02d°%02d'", z.Sign.Symbol(), z.Degrees, z.Minutes)
+}
+
+// View renders the relocation comparison component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("RelocationTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
@@ -0,0 +1,164 @@
+// Package sky provides the local sky table component: altitude, azimuth
+// and rise, transit and set times of each body at the chart location.
+package sky
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Model is the sky component state.
+type Model struct {
+	viewport viewport.Model
+	table    table.Model
+	sky      []position.SkyPosition
+	hasChart bool
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new sky model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the sky component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the sky component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.refresh()
+	return m
+}
+
+// SetChart sets the natal chart whose local sky is shown.
+func (m Model) SetChart(chart *horoscope.Chart) Model {
+	m.sky = chart.Sky
+	m.hasChart = true
+	if m.width > 0 {
+		m.refresh()
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m *Model) refresh() {
+	if !m.hasChart {
+		m.viewport.SetContent(styles.DimStyle.Render(i18n.T("StatusWaitingNatal")))
+		return
+	}
+	m.table = m.buildTable()
+	m.viewport.SetContent(m.table.View())
+}
+
+func (m Model) buildTable() table.Model {
+	availableWidth := max(m.width-9, 30)
+	planetWidth := max(availableWidth-3-2-6-5-3*6, 8)
+
+	columns := []table.Column{
+		{Title: "", Width: 3},
+		{Title: i18n.T("PositionPlanet"), Width: planetWidth},
+		{Title: "", Width: 2},
+		{Title: i18n.T("SkyAltitude"), Width: 6},
+		{Title: i18n.T("SkyAzimuth"), Width: 5},
+		{Title: i18n.T("SkyRise"), Width: 6},
+		{Title: i18n.T("SkyTransit"), Width: 6},
+		{Title: i18n.T("SkySet"), Width: 6},
+	}
+
+	rows := make([]table.Row, 0, len(m.sky))
+	for _, s := range m.sky {
+		horizon := "↓"
+		if s.AboveHorizon {
+			horizon = "↑"
+		}
+		rows = append(rows, table.Row{
+			s.Body.Symbol(),
+			s.Body.String(),
+			horizon,
+			fmt.Sprintf("%+.0f°", s.Altitude),
+			fmt.Sprintf("%.0f°", s.Azimuth),
+			formatTime(s.Rise, s.HasRise),
+			formatTime(s.Transit, true),
+			formatTime(s.Set, s.HasSet),
+		})
+	}
+
+	st := table.DefaultStyles()
+	st.Header = st.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("94")).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(styles.ColorBright)
+	st.Cell = st.Cell.Foreground(styles.ColorTextWarm)
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithHeight(len(rows)+1),
+		table.WithStyles(st),
+	)
+	t.Blur()
+
+	return t
+}
+
+func formatTime(t time.Time, ok bool) string {
+	if !ok {
+		return "—"
+	}
+	return t.Format("15:04")
+}
+
+// View renders the sky component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("SkyTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
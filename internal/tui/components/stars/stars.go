@@ -0,0 +1,151 @@
+// Package stars provides the fixed star contacts component.
+package stars
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// Model is the fixed stars component state.
+type Model struct {
+	viewport viewport.Model
+	contacts []horoscope.StarContact
+	hasChart bool
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new fixed stars model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the fixed stars component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the fixed stars component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.viewport.SetContent(m.buildContent())
+	return m
+}
+
+// SetChart sets the natal chart whose star contacts are listed.
+func (m Model) SetChart(chart *horoscope.Chart) Model {
+	m.contacts = chart.StarContacts
+	m.hasChart = true
+	if m.width > 0 {
+		m.viewport.SetContent(m.buildContent())
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m Model) buildContent() string {
+	if !m.hasChart {
+		return styles.DimStyle.Render(i18n.T("StatusWaitingNatal"))
+	}
+	if len(m.contacts) == 0 {
+		return styles.DimStyle.Render(i18n.T("StarNone"))
+	}
+
+	var sb strings.Builder
+	section := horoscope.StarContactType(-1)
+	for _, c := range m.contacts {
+		if c.Type != section {
+			section = c.Type
+			title := i18n.T("StarConjunctions")
+			if section == horoscope.StarParan {
+				title = i18n.T("StarParans")
+			}
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(styles.LabelStyle.Render(title) + "\n")
+		}
+
+		star := styles.NeutralStyle.Bold(true).Render("★ "+c.Star.Name) +
+			styles.DimStyle.Render(fmt.Sprintf(" %.1f", c.Star.Magnitude))
+
+		if c.Type == horoscope.StarParan {
+			sb.WriteString(fmt.Sprintf("%s %s / %s %s %s",
+				star, styles.DimStyle.Render(eventLabel(c.StarEvent)),
+				pointLabel(c), styles.DimStyle.Render(eventLabel(c.BodyEvent)),
+				styles.DimStyle.Render(fmt.Sprintf("%.2f°", c.Orb))))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s ☌ %s %s",
+				star, pointLabel(c), styles.DimStyle.Render(fmt.Sprintf("%.2f°", c.Orb))))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func pointLabel(c horoscope.StarContact) string {
+	if c.OnAngle {
+		return styles.LabelStyle.Render(c.Angle.Symbol())
+	}
+	return styles.StylePlanet(c.Body) + " " + c.Body.String()
+}
+
+// eventLabel returns the translated name of a horizon event.
+func eventLabel(e horoscope.HorizonEvent) string {
+	switch e {
+	case horoscope.Culminating:
+		return i18n.T("StarCulminating")
+	case horoscope.Setting:
+		return i18n.T("StarSetting")
+	case horoscope.AntiCulminating:
+		return i18n.T("StarAntiCulminating")
+	}
+	return i18n.T("StarRising")
+}
+
+// View renders the fixed stars component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("StarTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
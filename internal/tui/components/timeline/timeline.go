@@ -0,0 +1,160 @@
+// Package timeline provides the time lords component: annual and monthly
+// profections, firdaria and zodiacal releasing periods running today.
+package timeline
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// dateFormat is the format of period boundaries
+const dateFormat = "02/01/2006"
+
+// Model is the timeline component state.
+type Model struct {
+	viewport viewport.Model
+	chart    *horoscope.Chart
+	now      time.Time
+	width    int
+	height   int
+	focused  bool
+}
+
+// New creates a new timeline model.
+func New() Model {
+	return Model{}
+}
+
+// Init initializes the timeline component.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the timeline component.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.focused {
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
+	return m, cmd
+}
+
+// SetSize sets the component dimensions.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width-4, height-5)
+	m.viewport.SetContent(m.buildContent())
+	return m
+}
+
+// SetChart sets the natal chart and the date whose periods are shown.
+func (m Model) SetChart(chart *horoscope.Chart, now time.Time) Model {
+	m.chart = chart
+	m.now = now
+	if m.width > 0 {
+		m.viewport.SetContent(m.buildContent())
+	}
+	return m
+}
+
+// SetFocus sets the focus state of the component.
+func (m Model) SetFocus(focused bool) Model {
+	m.focused = focused
+	return m
+}
+
+func (m Model) buildContent() string {
+	if m.chart == nil {
+		return styles.DimStyle.Render(i18n.T("StatusWaitingNatal"))
+	}
+	tl := m.chart.TimeLordsAt(m.now)
+
+	var sb strings.Builder
+	if tl.HasProfections {
+		sb.WriteString(styles.LabelStyle.Render(i18n.T("TimelineProfections")) + "\n")
+		sb.WriteString(profectionLine(fmt.Sprintf("%s (%s %d)", i18n.T("TimelineYear"), i18n.T("TimelineAge"), tl.Year.Age), tl.Year))
+		sb.WriteString(profectionLine(i18n.T("TimelineMonth"), tl.Month))
+	}
+
+	if tl.HasFirdaria {
+		sb.WriteString("\n" + styles.LabelStyle.Render(i18n.T("TimelineFirdaria")) + "\n")
+		for _, major := range m.chart.Firdaria() {
+			line := fmt.Sprintf("%s %s  %s – %s", styles.StylePlanet(major.Lord), major.Lord.String(),
+				major.Start.Format(dateFormat), major.End.Format(dateFormat))
+			if major.Lord != tl.Firdaria.Lord {
+				sb.WriteString(styles.DimStyle.Render(line) + "\n")
+				continue
+			}
+			sb.WriteString(styles.NeutralStyle.Bold(true).Render("▸ ") + line + "\n")
+			if !tl.FirdariaMinor.Major {
+				sb.WriteString(fmt.Sprintf("    / %s %s %s\n", styles.StylePlanet(tl.FirdariaMinor.SubLord),
+					tl.FirdariaMinor.SubLord.String(), until(tl.FirdariaMinor.End)))
+			}
+		}
+	}
+
+	if len(tl.Fortune) > 0 || len(tl.Spirit) > 0 {
+		sb.WriteString("\n" + styles.LabelStyle.Render(i18n.T("TimelineReleasing")) + "\n")
+		sb.WriteString(releasingLines(i18n.T("TimelineFortune"), tl.Fortune))
+		sb.WriteString(releasingLines(i18n.T("TimelineSpirit"), tl.Spirit))
+	}
+	return sb.String()
+}
+
+func profectionLine(label string, p horoscope.Profection) string {
+	return fmt.Sprintf("%s  %s %s · %s %d · %s %s %s\n",
+		styles.DimStyle.Render(label), p.Sign.Symbol(), p.Sign.String(),
+		i18n.T("TimelineHouse"), p.House, styles.StylePlanet(p.Lord), p.Lord.String(), until(p.End))
+}
+
+func releasingLines(label string, periods []horoscope.ReleasingPeriod) string {
+	var sb strings.Builder
+	for _, p := range periods {
+		line := fmt.Sprintf("%s L%d  %s %s %s", styles.DimStyle.Render(label), p.Level,
+			p.Sign.Symbol(), p.Sign.String(), until(p.End))
+		if p.Peak {
+			line += " " + styles.NeutralStyle.Bold(true).Render("★ "+i18n.T("TimelinePeak"))
+		}
+		if p.LoosingOfBond {
+			line += " " + styles.LabelStyle.Render(i18n.T("TimelineLoosing"))
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String()
+}
+
+func until(t time.Time) string {
+	return styles.DimStyle.Render(fmt.Sprintf("(%s %s)", i18n.T("TimelineUntil"), t.Format(dateFormat)))
+}
+
+// View renders the timeline component.
+func (m Model) View() string {
+	borderColor := lipgloss.Color("94")
+	if m.focused {
+		borderColor = styles.ColorPrimary
+	}
+
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorBright).
+		Render(i18n.T("TimelineTitle"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(m.width-2).
+		Height(m.height-2).
+		Padding(0, 1)
+
+	return box.Render(header + "\n" + m.viewport.View())
+}
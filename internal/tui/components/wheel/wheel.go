@@ -4,6 +4,7 @@ package wheel
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -12,6 +13,7 @@ import (
 	"github.com/ctrl-vfr/astral-tui/internal/i18n"
 	"github.com/ctrl-vfr/astral-tui/internal/render"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/messages"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
 	"github.com/ctrl-vfr/astral-tui/pkg/position"
 )
 
@@ -19,21 +21,27 @@ const imageID = 42 // Fixed image ID for the zodiac wheel
 
 // Model is the zodiac wheel component state.
 type Model struct {
-	positions        []position.Position
-	pngData          []byte
-	width            int
-	height           int
-	loading          bool
-	imageReady       bool
-	imageTransmitted bool
-	cols             int
-	rows             int
-	err              error
-}
-
-// New creates a new wheel model.
+	positions   []position.Position
+	patterns    []horoscope.Pattern
+	pngData     []byte
+	output      render.ImageOutput // Nil when the terminal cannot show images
+	text        *textWheel         // The wheel drawn as text, without images
+	width       int
+	height      int
+	loading     bool
+	imageReady  bool
+	imagePlaced bool
+	lines       []string // Rows drawing the placed image
+	cols        int
+	rows        int
+	err         error
+}
+
+// New creates a new wheel model, displaying the wheel with the graphics
+// protocol of the terminal, or drawing it with text when it has none.
 func New() Model {
-	return Model{}
+	caps := render.GetTerminalCapabilities()
+	return Model{output: render.NewImageOutput(caps.Protocol(), caps, imageID)}
 }
 
 // Init initializes the wheel component.
@@ -51,30 +59,70 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		} else {
 			m.pngData = msg.PNGData
 			m.imageReady = true
-			// Transmit image to terminal for virtual placement
-			return m, m.transmitImage()
+			if m.output == nil {
+				m.text = &textWheel{natal: msg.Natal, transits: msg.Transits, patterns: m.patterns}
+				m = m.drawText()
+				return m, nil
+			}
+			// Prepare the image for the terminal
+			return m, m.placeImage()
+		}
+	case ImagePlacedMsg:
+		// A placement made for a previous size is outdated
+		if msg.Cols == m.cols && msg.Rows == m.rows {
+			m.lines = msg.Lines
+			m.imagePlaced = true
 		}
-	case ImageTransmittedMsg:
-		m.imageTransmitted = true
 	}
 	return m, nil
 }
 
-// ImageTransmittedMsg signals that the image has been transmitted to terminal
-type ImageTransmittedMsg struct{}
+// textWheel holds what the wheel drawn as text shows, to draw it again at
+// each size
+type textWheel struct {
+	natal    []position.Position
+	transits []position.Position
+	patterns []horoscope.Pattern
+}
+
+// drawText draws the wheel as text in the size of the component
+func (m Model) drawText() Model {
+	if m.text == nil {
+		return m
+	}
+	m.lines = render.NewTextWheelGenerator(m.cols, m.rows).
+		SetPatterns(m.text.patterns).
+		GenerateWithTransits(m.text.natal, m.text.transits)
+	m.imagePlaced = true
+	return m
+}
+
+// ImagePlacedMsg signals that the image has been prepared for the terminal,
+// with the rows drawing it
+type ImagePlacedMsg struct {
+	Lines []string
+	Cols  int
+	Rows  int
+}
 
-func (m Model) transmitImage() tea.Cmd {
+func (m Model) placeImage() tea.Cmd {
+	if m.output == nil {
+		return nil
+	}
+	output := m.output
 	pngData := m.pngData
 	cols := m.cols
 	rows := m.rows
 	return func() tea.Msg {
-		// Delete any previous image with this ID
-		render.DeleteImage(os.Stdout, imageID)
-		// Transmit and create virtual placement
-		if err := render.TransmitAndCreatePlacement(os.Stdout, pngData, imageID, cols, rows); err != nil {
+		img, err := render.PNGToImage(pngData)
+		if err != nil {
+			return messages.WheelGeneratedMsg{Err: err}
+		}
+		lines, err := output.Place(os.Stdout, img, cols, rows)
+		if err != nil {
 			return messages.WheelGeneratedMsg{Err: err}
 		}
-		return ImageTransmittedMsg{}
+		return ImagePlacedMsg{Lines: lines, Cols: cols, Rows: rows}
 	}
 }
 
@@ -92,17 +140,26 @@ func (m Model) SetSize(width, height int) Model {
 	if m.rows < 5 {
 		m.rows = 5
 	}
-	// Reset transmission state when size changes
-	m.imageTransmitted = false
-	return m
+	// Reset placement state when size changes
+	m.imagePlaced = false
+	// Text is drawn again right away
+	return m.drawText()
 }
 
 // SetPositions sets the natal positions for the wheel.
 func (m Model) SetPositions(positions []position.Position) Model {
 	m.positions = positions
+	m.patterns = nil
 	m.loading = true
 	m.imageReady = false
-	m.imageTransmitted = false
+	m.imagePlaced = false
+	m.text = nil
+	return m
+}
+
+// SetPatterns sets the natal aspect patterns to highlight on the wheel.
+func (m Model) SetPatterns(patterns []horoscope.Pattern) Model {
+	m.patterns = patterns
 	return m
 }
 
@@ -114,13 +171,23 @@ func (m Model) HasPositions() bool {
 // GenerateWheel generates the zodiac wheel image.
 func (m Model) GenerateWheel() tea.Cmd {
 	natalPositions := m.positions
+	patterns := m.patterns
+	textOnly := m.output == nil
 	return func() tea.Msg {
-		svgSize := 600
-		generator := render.NewSVGWheelGenerator(svgSize)
-
 		// Calculate today's transits
 		transitPositions := position.CalculateAll(time.Now())
 
+		if textOnly {
+			if len(natalPositions) == 0 {
+				// Transits take the natal ring, as on the image
+				return messages.WheelGeneratedMsg{Natal: transitPositions}
+			}
+			return messages.WheelGeneratedMsg{Natal: natalPositions, Transits: transitPositions}
+		}
+
+		svgSize := 600
+		generator := render.NewSVGWheelGenerator(svgSize).SetPatterns(patterns)
+
 		var svgData []byte
 		if len(natalPositions) == 0 {
 			// No natal data yet - show transits only (on inner ring)
@@ -141,11 +208,15 @@ func (m Model) GenerateWheel() tea.Cmd {
 
 // GenerateTransitsOnly generates a wheel with only today's transits
 func (m Model) GenerateTransitsOnly() tea.Cmd {
+	textOnly := m.output == nil
 	return func() tea.Msg {
+		transitPositions := position.CalculateAll(time.Now())
+		if textOnly {
+			return messages.WheelGeneratedMsg{Natal: transitPositions}
+		}
+
 		svgSize := 600
 		generator := render.NewSVGWheelGenerator(svgSize)
-
-		transitPositions := position.CalculateAll(time.Now())
 		svgData := generator.Generate(transitPositions)
 
 		pngData, err := render.SVGToPNG(svgData, svgSize, svgSize)
@@ -174,34 +245,17 @@ func (m Model) View() string {
 		return borderStyle.Render(fmt.Sprintf("%s%v", i18n.T("StatusError"), m.err))
 	}
 
-	if !m.imageReady || len(m.pngData) == 0 {
+	if !m.imageReady || (m.output != nil && len(m.pngData) == 0) {
 		return borderStyle.Render(i18n.T("StatusWaitingData"))
 	}
 
-	// Check if terminal supports Kitty graphics
-	if !render.SupportsKittyGraphics() || !render.HasResvg() {
-		return m.asciiPlaceholder()
-	}
-
-	// Wait for image to be transmitted before showing placeholders
-	if !m.imageTransmitted {
+	// Wait for image to be placed before showing its rows
+	if !m.imagePlaced {
 		return borderStyle.Render(i18n.T("StatusTransmittingImage"))
 	}
 
-	// Return Unicode placeholder grid wrapped in border
-	grid := render.BuildPlaceholderGrid(imageID, m.cols, m.rows)
-	return borderStyle.Render(grid)
-}
-
-func (m Model) asciiPlaceholder() string {
-	style := lipgloss.NewStyle().
-		Width(m.width).
-		Height(m.height).
-		Border(lipgloss.RoundedBorder()).
-		Align(lipgloss.Center, lipgloss.Center).
-		Foreground(lipgloss.Color("240"))
-
-	return style.Render(i18n.T("WheelPlaceholder"))
+	// Return the rows drawing the image, or the text wheel, wrapped in border
+	return borderStyle.Render(strings.Join(m.lines, "\n"))
 }
 
 // IsReady returns true if the wheel image is ready to display.
@@ -209,7 +263,7 @@ func (m Model) IsReady() bool {
 	return m.imageReady && len(m.pngData) > 0
 }
 
-// RetransmitImage retransmits the wheel image to the terminal.
+// RetransmitImage places the wheel image again, after a resize.
 func (m Model) RetransmitImage() tea.Cmd {
-	return m.transmitImage()
+	return m.placeImage()
 }
@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"io"
+	"os"
+
+	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// export writes what the detail panel shows as JSON in the working
+// directory: the BaZi pillars, or the chart on the wheel. It returns the
+// status line to display.
+func (m Model) export() string {
+	if pillars, ok := m.pillars.Export(); ok && m.detail == DetailBaZi {
+		name := "astral-" + m.chart.DateTime.Format("20060102-1504") + "-bazi.json"
+		return writeExport(name, pillars.WriteJSON)
+	}
+	return exportChart(m.displayedChart())
+}
+
+// exportChart writes the chart as JSON in the working directory
+// and returns the status line to display.
+func exportChart(chart *horoscope.Chart) string {
+	name := "astral-" + chart.DateTime.Format("20060102-1504")
+	if chart.Heliocentric {
+		name += "-helio"
+	}
+	if chart.Relocation != nil {
+		name += "-relocated"
+	}
+	name += ".json"
+	return writeExport(name, chart.WriteJSON)
+}
+
+// writeExport creates a file and writes an export to it
+func writeExport(name string, write func(io.Writer) error) string {
+	f, err := os.Create(name)
+	if err != nil {
+		return i18n.T("StatusExportError") + err.Error()
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return i18n.T("StatusExportError") + err.Error()
+	}
+	if err := f.Close(); err != nil {
+		return i18n.T("StatusExportError") + err.Error()
+	}
+	return i18n.T("StatusExported") + name
+}
@@ -4,7 +4,9 @@ package messages
 import (
 	"time"
 
+	"github.com/ctrl-vfr/astral-tui/pkg/election"
 	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
 )
 
 // FormSubmittedMsg is sent when the user submits the form
@@ -28,12 +30,21 @@ type GeocodingResultMsg struct {
 	Latitude    float64
 	Longitude   float64
 	DisplayName string
+	Relocation  *Place // Nil when no relocation city was given
 	Err         error
 }
 
+// Place is a geocoded place
+type Place struct {
+	Latitude    float64
+	Longitude   float64
+	DisplayName string
+}
+
 // ChartReadyMsg is sent when the chart is ready
 type ChartReadyMsg struct {
-	Chart *horoscope.Chart
+	Chart     *horoscope.Chart
+	Relocated *horoscope.Chart // Nil when no relocation city was given
 }
 
 // ChartErrorMsg is sent when the chart generation fails
@@ -41,12 +52,23 @@ type ChartErrorMsg struct {
 	Err error
 }
 
-// WheelGeneratedMsg is sent when the wheel is generated
-type WheelGeneratedMsg struct {
-	PNGData []byte
+// ElectionReadyMsg is sent when the electional search is done
+type ElectionReadyMsg struct {
+	Search  int // Generation of the search, see electional.Model.Search
+	Windows []election.Window
 	Err     error
 }
 
+// WheelGeneratedMsg is sent when the wheel is generated. Without terminal
+// graphics, it carries the positions to draw the wheel as text instead of
+// PNGData.
+type WheelGeneratedMsg struct {
+	PNGData  []byte
+	Natal    []position.Position
+	Transits []position.Position
+	Err      error
+}
+
 // InterpReadyMsg is sent when the interpretation is ready
 type InterpReadyMsg struct {
 	Content string
@@ -1,15 +1,27 @@
 package tui
 
 import (
+	"os"
+	"strconv"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	zone "github.com/lrstanley/bubblezone"
 
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/dignities"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/electional"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/components/form"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/components/header"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/helio"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/components/interp"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/overview"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/patterns"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/pillars"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/components/positions"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/relocation"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/sky"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/stars"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/timeline"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/components/wheel"
 	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
 	"github.com/ctrl-vfr/astral-tui/pkg/position"
@@ -25,38 +37,105 @@ const (
 	FocusPositions
 )
 
+// DetailPanel represents the panel shown below the wheel.
+type DetailPanel int
+
+// Detail panels, cycled with left/right when the panel area is focused.
+const (
+	DetailPositions DetailPanel = iota
+	DetailDignities
+	DetailPatterns
+	DetailStars
+	DetailSky
+	DetailHelio
+	DetailTimeline
+	DetailRelocation
+	DetailElection
+	DetailOverview
+	DetailBaZi
+	detailPanelCount
+)
+
 // Model is the main TUI application model.
 type Model struct {
 	width  int
 	height int
 
-	header    header.Model
-	form      form.Model
-	wheel     wheel.Model
-	interp    interp.Model
-	positions positions.Model
+	header     header.Model
+	form       form.Model
+	wheel      wheel.Model
+	interp     interp.Model
+	positions  positions.Model
+	dignities  dignities.Model
+	patterns   patterns.Model
+	stars      stars.Model
+	sky        sky.Model
+	helio      helio.Model
+	timeline   timeline.Model
+	relocation relocation.Model
+	electional electional.Model
+	overview   overview.Model
+	pillars    pillars.Model
 
-	chart   *horoscope.Chart
-	focus   FocusArea
-	loading bool
-	status  string
+	chart      *horoscope.Chart
+	helioChart *horoscope.Chart
+	relocated  *horoscope.Chart
+	options    position.Options
+	starOrb    float64
+	focus      FocusArea
+	detail     DetailPanel
+	loading    bool
+	status     string
 }
 
 // NewModel creates a new TUI model with default state.
 func NewModel() Model {
 	zone.NewGlobal()
 
+	options := optionsFromEnv()
 	today := time.Now()
-	todayPositions := position.CalculateAll(today)
+	todayPositions := position.CalculateAllWithOptions(today, options)
 
 	return Model{
-		header:    header.New().SetPositions(todayPositions),
-		form:      form.New(),
-		wheel:     wheel.New(),
-		interp:    interp.New(),
-		positions: positions.New().SetPositions(todayPositions),
-		focus:     FocusForm,
+		header:     header.New().SetPositions(todayPositions),
+		form:       form.New(),
+		wheel:      wheel.New(),
+		interp:     interp.New(),
+		positions:  positions.New().SetPositions(todayPositions),
+		dignities:  dignities.New(),
+		patterns:   patterns.New(),
+		stars:      stars.New(),
+		sky:        sky.New(),
+		helio:      helio.New(),
+		timeline:   timeline.New(),
+		relocation: relocation.New(),
+		electional: electional.New(),
+		overview:   overview.New(),
+		pillars:    pillars.New(),
+		options:    options,
+		starOrb:    starOrbFromEnv(),
+		focus:      FocusForm,
+	}
+}
+
+// optionsFromEnv reads the node and Lilith models from ASTRAL_NODES and ASTRAL_LILITH.
+func optionsFromEnv() position.Options {
+	options := position.DefaultOptions
+	if os.Getenv("ASTRAL_NODES") == "true" {
+		options.Nodes = position.TrueNode
+	}
+	if os.Getenv("ASTRAL_LILITH") == "osculating" {
+		options.Lilith = position.OsculatingLilith
+	}
+	return options
+}
+
+// starOrbFromEnv reads the fixed star orb in degrees from ASTRAL_STAR_ORB.
+func starOrbFromEnv() float64 {
+	if orb, err := strconv.ParseFloat(os.Getenv("ASTRAL_STAR_ORB"), 64); err == nil && orb > 0 {
+		return orb
 	}
+	return horoscope.DefaultStarOrb
 }
 
 // Init initializes the TUI application.
@@ -5,14 +5,18 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
-	"github.com/ctrl-vfr/astral-tui/internal/house"
 	"github.com/ctrl-vfr/astral-tui/internal/i18n"
+	"github.com/ctrl-vfr/astral-tui/internal/tui/components/header"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/components/wheel"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/messages"
-	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+	"github.com/ctrl-vfr/astral-tui/pkg/election"
 	"github.com/ctrl-vfr/astral-tui/pkg/position"
 )
 
+// electionDays is the number of days searched for electional criteria
+const electionDays = 90
+
 // Update handles messages for the main TUI model.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
@@ -33,10 +37,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.form = m.form.Reset()
 				m.interp = m.interp.Reset()
 				m.chart = nil
+				m.helioChart = nil
+				m.relocated = nil
+				m.header = m.header.ClearPlace()
+				m.electional = m.electional.SetSearching("")
 				m.focus = FocusForm
+				m.detail = DetailPositions
 				m = m.updateFocus()
 				return m, m.form.Init()
 			}
+		case "ctrl+e":
+			if m.chart != nil {
+				m.status = m.export()
+			}
+		case "left", "right":
+			if m.focus == FocusPositions {
+				shown := m.displayedChart()
+				m.cycleDetail(msg.String() == "right")
+				m = m.updateFocus()
+				if shown != m.displayedChart() {
+					m = m.showChartOnWheel()
+					cmds = append(cmds, m.wheel.GenerateWheel())
+				}
+			}
 		}
 
 	case tea.WindowSizeMsg:
@@ -48,14 +71,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case messages.DateChangedMsg:
-		positions := position.CalculateAll(msg.Date)
+		positions := position.CalculateAllWithOptions(msg.Date, m.options)
 		m.header = m.header.SetPositions(positions)
 		m.wheel = m.wheel.SetPositions(positions)
 		m.positions = m.positions.SetPositions(positions)
 		cmds = append(cmds, m.wheel.GenerateWheel())
 
 	case messages.TransitDateChangedMsg:
-		transitPositions := position.CalculateAll(msg.Date)
+		transitPositions := position.CalculateAllWithOptions(msg.Date, m.options)
 		m.positions = m.positions.SetTransits(transitPositions)
 
 	case messages.GeocodingResultMsg:
@@ -65,40 +88,79 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			m.status = i18n.T("StatusCalculating")
 			dateTime, _ := m.form.GetDateTime()
-			cmds = append(cmds, m.calculateChart(dateTime, msg.Latitude, msg.Longitude, msg.DisplayName))
+			cmds = append(cmds, m.calculateChart(dateTime, msg.Latitude, msg.Longitude, msg.DisplayName, msg.Relocation))
 		}
 
+	case messages.ChartErrorMsg:
+		m.loading = false
+		m.status = i18n.T("StatusError") + msg.Err.Error()
+		m.form = m.form.Reset()
+
 	case messages.ChartReadyMsg:
 		m.chart = msg.Chart
+		m.helioChart = m.chart.HeliocentricChart()
+		m.relocated = msg.Relocated
 		m.loading = false
 		m.status = ""
 
-		m.header = m.header.SetChart(m.chart.DateTime, m.chart.Location, m.chart.Positions)
-		m.wheel = m.wheel.SetPositions(m.chart.Positions)
+		m.header = m.header.SetChart(m.chart)
+		// The Oracle and the planetary hours follow the relocated chart,
+		// which also carries the natal houses
+		current := m.chart
+		if m.relocated != nil {
+			current = m.relocated
+		}
+		var headerCmd tea.Cmd
+		m.header, headerCmd = m.header.SetPlace(current.Latitude, current.Longitude)
+		cmds = append(cmds, headerCmd)
+		m = m.showChartOnWheel()
 		m.positions = m.positions.SetChart(m.chart)
+		m.dignities = m.dignities.SetChart(m.chart)
+		m.patterns = m.patterns.SetChart(m.chart)
+		m.stars = m.stars.SetChart(m.chart)
+		m.sky = m.sky.SetChart(m.chart)
+		m.helio = m.helio.SetCharts(m.chart, m.helioChart)
+		m.timeline = m.timeline.SetChart(m.chart, time.Now())
+		m.relocation = m.relocation.SetCharts(m.chart, m.relocated)
+		m.overview = m.overview.SetChart(m.chart)
+		m.pillars = m.pillars.SetChart(m.chart, m.form.GetSex())
+
+		criteria := m.form.GetElectionCriteria()
+		m.electional = m.electional.SetSearching(criteria)
+		if criteria != "" {
+			cmds = append(cmds, m.searchElection(criteria, m.electional.Search()))
+		}
 
 		// Set transit positions from form's transit date
 		if transitDate, err := m.form.GetTransitDateTime(); err == nil {
-			transitPositions := position.CalculateAll(transitDate)
+			transitPositions := position.CalculateAllWithOptions(transitDate, m.options)
 			m.positions = m.positions.SetTransits(transitPositions)
 		}
 
 		cmds = append(cmds, m.wheel.GenerateWheel())
 
 		userContext := m.form.GetUserContext()
-		interpModel, interpCmd := m.interp.StartInterpretation(m.chart, userContext)
+		interpModel, interpCmd := m.interp.StartInterpretation(current, userContext)
 		m.interp = interpModel
 		cmds = append(cmds, interpCmd)
 
 		m.focus = FocusInterp
 		m = m.updateFocus()
 
+	case messages.ElectionReadyMsg:
+		m.electional = m.electional.SetResults(msg.Search, msg.Windows, msg.Err)
+
 	case messages.WheelGeneratedMsg:
 		var wheelCmd tea.Cmd
 		m.wheel, wheelCmd = m.wheel.Update(msg)
 		cmds = append(cmds, wheelCmd)
 
-	case wheel.ImageTransmittedMsg:
+	case header.TickMsg:
+		var headerCmd tea.Cmd
+		m.header, headerCmd = m.header.Update(msg)
+		cmds = append(cmds, headerCmd)
+
+	case wheel.ImagePlacedMsg:
 		m.wheel, _ = m.wheel.Update(msg)
 
 	case messages.InterpReadyMsg:
@@ -120,30 +182,85 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.interp, interpCmd = m.interp.Update(msg)
 		cmds = append(cmds, interpCmd)
 	case FocusPositions:
-		var posCmd tea.Cmd
-		m.positions, posCmd = m.positions.Update(msg)
-		cmds = append(cmds, posCmd)
+		var detailCmd tea.Cmd
+		switch m.detail {
+		case DetailDignities:
+			m.dignities, detailCmd = m.dignities.Update(msg)
+		case DetailPatterns:
+			m.patterns, detailCmd = m.patterns.Update(msg)
+		case DetailStars:
+			m.stars, detailCmd = m.stars.Update(msg)
+		case DetailSky:
+			m.sky, detailCmd = m.sky.Update(msg)
+		case DetailHelio:
+			m.helio, detailCmd = m.helio.Update(msg)
+		case DetailTimeline:
+			m.timeline, detailCmd = m.timeline.Update(msg)
+		case DetailRelocation:
+			m.relocation, detailCmd = m.relocation.Update(msg)
+		case DetailElection:
+			m.electional, detailCmd = m.electional.Update(msg)
+		case DetailOverview:
+			m.overview, detailCmd = m.overview.Update(msg)
+		case DetailBaZi:
+			m.pillars, detailCmd = m.pillars.Update(msg)
+		default:
+			m.positions, detailCmd = m.positions.Update(msg)
+		}
+		cmds = append(cmds, detailCmd)
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
-func (m Model) calculateChart(dateTime time.Time, lat, lon float64, location string) tea.Cmd {
+func (m Model) calculateChart(dateTime time.Time, lat, lon float64, location string, relocation *messages.Place) tea.Cmd {
+	options := m.options
+	starOrb := m.starOrb
 	return func() tea.Msg {
-		positions := position.CalculateAll(dateTime)
-		houseCusps := house.Calculate(lat, lon, dateTime)
-		aspects := horoscope.CalculateAspects(positions, horoscope.DefaultOrbs)
+		cfg := batch.DefaultConfig
+		cfg.Options = options
+		cfg.StarOrb = starOrb
 
-		chart := &horoscope.Chart{
+		chart, err := batch.NewChart(batch.Record{
 			DateTime:  dateTime,
 			Latitude:  lat,
 			Longitude: lon,
 			Location:  location,
-			Positions: positions,
-			Houses:    houseCusps,
-			Aspects:   aspects,
+		}, cfg)
+		if err != nil {
+			return messages.ChartErrorMsg{Err: err}
+		}
+		if relocation == nil {
+			return messages.ChartReadyMsg{Chart: chart}
+		}
+		relocated, err := batch.Relocate(chart, relocation.Latitude, relocation.Longitude, relocation.DisplayName, cfg)
+		if err != nil {
+			return messages.ChartErrorMsg{Err: err}
 		}
+		return messages.ChartReadyMsg{Chart: chart, Relocated: relocated}
+	}
+}
 
-		return messages.ChartReadyMsg{Chart: chart}
+// searchElection searches the electional criteria over the electionDays
+// following the transit date of the form. search tags the results so that
+// those of a superseded search are dropped.
+func (m Model) searchElection(criteria string, search int) tea.Cmd {
+	natal := m.chart
+	options := m.options
+	from, err := m.form.GetTransitDateTime()
+	if err != nil {
+		from = time.Now()
+	}
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	return func() tea.Msg {
+		parsed, err := election.Parse(criteria)
+		if err != nil {
+			return messages.ElectionReadyMsg{Search: search, Err: err}
+		}
+		cfg := election.DefaultConfig
+		cfg.Natal = natal
+		cfg.Options = options
+		windows, err := election.Search(parsed, from, from.AddDate(0, 0, electionDays), cfg)
+		return messages.ElectionReadyMsg{Search: search, Windows: windows, Err: err}
 	}
 }
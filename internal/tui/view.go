@@ -6,6 +6,7 @@ import (
 
 	"github.com/ctrl-vfr/astral-tui/internal/i18n"
 	"github.com/ctrl-vfr/astral-tui/internal/tui/styles"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
 )
 
 // View renders the main TUI view.
@@ -22,7 +23,7 @@ func (m Model) buildView() string {
 
 	leftCol := lipgloss.JoinVertical(lipgloss.Left,
 		m.wheel.View(),
-		m.positions.View(),
+		m.detailView(),
 	)
 
 	var rightCol string
@@ -52,6 +53,8 @@ func (m Model) renderHelpLine() string {
 		keyStyle.Render("↑↓") + sepStyle.Render(i18n.T("NavScroll")+" • ")
 
 	if m.chart != nil {
+		help += keyStyle.Render("←→") + sepStyle.Render(i18n.T("NavPanels")+" • ")
+		help += keyStyle.Render("ctrl+e") + sepStyle.Render(i18n.T("NavExport")+" • ")
 		help += keyStyle.Render("esc") + sepStyle.Render(i18n.T("NavNewQuestion")+" • ")
 	}
 
@@ -74,6 +77,16 @@ func (m Model) updateLayout() Model {
 	m.header = m.header.SetSize(m.width)
 	m.wheel = m.wheel.SetSize(leftWidth, wheelHeight)
 	m.positions = m.positions.SetSize(leftWidth, posHeight)
+	m.dignities = m.dignities.SetSize(leftWidth, posHeight)
+	m.patterns = m.patterns.SetSize(leftWidth, posHeight)
+	m.stars = m.stars.SetSize(leftWidth, posHeight)
+	m.sky = m.sky.SetSize(leftWidth, posHeight)
+	m.helio = m.helio.SetSize(leftWidth, posHeight)
+	m.timeline = m.timeline.SetSize(leftWidth, posHeight)
+	m.relocation = m.relocation.SetSize(leftWidth, posHeight)
+	m.electional = m.electional.SetSize(leftWidth, posHeight)
+	m.overview = m.overview.SetSize(leftWidth, posHeight)
+	m.pillars = m.pillars.SetSize(leftWidth, posHeight)
 	m.form = m.form.SetSize(rightWidth, contentHeight)
 	m.interp = m.interp.SetSize(rightWidth, contentHeight)
 
@@ -116,8 +129,77 @@ func (m *Model) cycleFocusReverse() {
 	}
 }
 
+func (m Model) detailView() string {
+	switch m.detail {
+	case DetailDignities:
+		return m.dignities.View()
+	case DetailPatterns:
+		return m.patterns.View()
+	case DetailStars:
+		return m.stars.View()
+	case DetailSky:
+		return m.sky.View()
+	case DetailHelio:
+		return m.helio.View()
+	case DetailTimeline:
+		return m.timeline.View()
+	case DetailRelocation:
+		return m.relocation.View()
+	case DetailElection:
+		return m.electional.View()
+	case DetailOverview:
+		return m.overview.View()
+	case DetailBaZi:
+		return m.pillars.View()
+	default:
+		return m.positions.View()
+	}
+}
+
+func (m *Model) cycleDetail(forward bool) {
+	if m.chart == nil {
+		return
+	}
+	if forward {
+		m.detail = (m.detail + 1) % detailPanelCount
+	} else {
+		m.detail = (m.detail + detailPanelCount - 1) % detailPanelCount
+	}
+}
+
 func (m Model) updateFocus() Model {
+	detailFocused := m.focus == FocusPositions
 	m.interp = m.interp.SetFocus(m.focus == FocusInterp)
-	m.positions = m.positions.SetFocus(m.focus == FocusPositions)
+	m.positions = m.positions.SetFocus(detailFocused && m.detail == DetailPositions)
+	m.dignities = m.dignities.SetFocus(detailFocused && m.detail == DetailDignities)
+	m.patterns = m.patterns.SetFocus(detailFocused && m.detail == DetailPatterns)
+	m.stars = m.stars.SetFocus(detailFocused && m.detail == DetailStars)
+	m.sky = m.sky.SetFocus(detailFocused && m.detail == DetailSky)
+	m.helio = m.helio.SetFocus(detailFocused && m.detail == DetailHelio)
+	m.timeline = m.timeline.SetFocus(detailFocused && m.detail == DetailTimeline)
+	m.relocation = m.relocation.SetFocus(detailFocused && m.detail == DetailRelocation)
+	m.electional = m.electional.SetFocus(detailFocused && m.detail == DetailElection)
+	m.overview = m.overview.SetFocus(detailFocused && m.detail == DetailOverview)
+	m.pillars = m.pillars.SetFocus(detailFocused && m.detail == DetailBaZi)
+	return m
+}
+
+// displayedChart returns the chart shown on the wheel: the heliocentric or
+// relocated chart while its comparison panel is open, the natal chart
+// otherwise.
+func (m Model) displayedChart() *horoscope.Chart {
+	if m.detail == DetailHelio && m.helioChart != nil {
+		return m.helioChart
+	}
+	if m.detail == DetailRelocation && m.relocated != nil {
+		return m.relocated
+	}
+	return m.chart
+}
+
+// showChartOnWheel puts the displayed chart's positions and patterns on the wheel.
+func (m Model) showChartOnWheel() Model {
+	chart := m.displayedChart()
+	m.wheel = m.wheel.SetPositions(chart.Positions).SetPatterns(chart.Patterns())
 	return m
 }
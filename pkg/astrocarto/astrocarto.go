@@ -0,0 +1,128 @@
+// Package astrocarto computes astrocartography lines: the places on Earth
+// where each natal body was rising, setting, culminating or anti-culminating
+// at the moment of birth.
+package astrocarto
+
+import (
+	"math"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Angle is the chart angle a body occupies along a line
+type Angle int
+
+// Angles of astrocartography lines.
+const (
+	Ascendant Angle = iota
+	Descendant
+	Midheaven
+	ImumCoeli
+)
+
+// String returns the abbreviation of the angle
+func (a Angle) String() string {
+	return angleNames[a]
+}
+
+var angleNames = map[Angle]string{
+	Ascendant:  "ASC",
+	Descendant: "DSC",
+	Midheaven:  "MC",
+	ImumCoeli:  "IC",
+}
+
+// Bodies are the bodies whose lines are drawn by default
+var Bodies = []position.CelestialBody{
+	position.Sun, position.Moon, position.Mercury, position.Venus, position.Mars,
+	position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto,
+}
+
+// MaxLatitude bounds the lines, which run towards the poles
+const MaxLatitude = 85.0
+
+// latitudeStep is the spacing in degrees of the points along a line
+const latitudeStep = 0.25
+
+// Point is a place on Earth in degrees, longitudes east positive
+type Point struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lon"`
+}
+
+// Line is the path on which a body occupies an angle. It is cut into
+// segments where it crosses the antimeridian and, for ASC and DSC lines,
+// where the body is circumpolar and never rises or sets.
+type Line struct {
+	Body     position.CelestialBody
+	Angle    Angle
+	Segments [][]Point
+}
+
+// Lines computes the four lines of each body present in the chart
+func Lines(chart *horoscope.Chart, bodies []position.CelestialBody) []Line {
+	gmst := position.LocalSiderealTime(position.JulianDay(chart.DateTime), 0)
+
+	var lines []Line
+	for _, body := range bodies {
+		pos := chart.GetPosition(body)
+		if pos == nil {
+			continue
+		}
+		ra, dec := pos.RightAscension, pos.Declination
+
+		mc := normalizeLongitude(ra - gmst)
+		lines = append(lines,
+			Line{Body: body, Angle: Midheaven, Segments: meridian(mc)},
+			Line{Body: body, Angle: ImumCoeli, Segments: meridian(normalizeLongitude(mc + 180))},
+			Line{Body: body, Angle: Ascendant, Segments: horizon(ra-gmst, dec, -1)},
+			Line{Body: body, Angle: Descendant, Segments: horizon(ra-gmst, dec, 1)},
+		)
+	}
+	return lines
+}
+
+// meridian returns the MC or IC line at a longitude, from pole to pole
+func meridian(longitude float64) [][]Point {
+	var points []Point
+	for lat := -MaxLatitude; lat <= MaxLatitude; lat += latitudeStep {
+		points = append(points, Point{Latitude: lat, Longitude: longitude})
+	}
+	return [][]Point{points}
+}
+
+// horizon returns the ASC (side -1) or DSC (side 1) line of a body whose
+// right ascension minus the sidereal time at Greenwich is mc. At latitude φ
+// the body is on the horizon at hour angle ±H where cos H = -tan φ tan δ.
+func horizon(mc, dec float64, side float64) [][]Point {
+	tanDec := math.Tan(position.DegreesToRadians(dec))
+
+	var segments [][]Point
+	var current []Point
+	for lat := -MaxLatitude; lat <= MaxLatitude; lat += latitudeStep {
+		x := -math.Tan(position.DegreesToRadians(lat)) * tanDec
+		if math.Abs(x) > 1 {
+			if len(current) > 1 {
+				segments = append(segments, current)
+			}
+			current = nil
+			continue
+		}
+		lon := normalizeLongitude(mc + side*position.RadiansToDegrees(math.Acos(x)))
+		if n := len(current); n > 0 && math.Abs(lon-current[n-1].Longitude) > 180 {
+			segments = append(segments, current)
+			current = nil
+		}
+		current = append(current, Point{Latitude: lat, Longitude: lon})
+	}
+	if len(current) > 1 {
+		segments = append(segments, current)
+	}
+	return segments
+}
+
+// normalizeLongitude reduces a longitude to [-180, 180)
+func normalizeLongitude(lon float64) float64 {
+	return position.NormalizeAngle(lon+180) - 180
+}
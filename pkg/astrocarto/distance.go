@@ -0,0 +1,98 @@
+package astrocarto
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// EarthRadius is the mean radius of the Earth in kilometres
+const EarthRadius = 6371.0
+
+// Proximity is a line passing near a place
+type Proximity struct {
+	Line     Line
+	Distance float64 // Kilometres from the place to the closest point of the line
+	Nearest  Point   // Closest point of the line
+}
+
+// Near returns the lines passing within radius kilometres of a place,
+// closest first
+func Near(lines []Line, place Point, radius float64) []Proximity {
+	var result []Proximity
+	for _, line := range lines {
+		best := Proximity{Line: line, Distance: math.Inf(1)}
+		for _, seg := range line.Segments {
+			for i := 1; i < len(seg); i++ {
+				if d, p := segmentDistance(place, seg[i-1], seg[i]); d < best.Distance {
+					best.Distance, best.Nearest = d, p
+				}
+			}
+		}
+		if best.Distance <= radius {
+			result = append(result, best)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Distance < result[j].Distance
+	})
+	return result
+}
+
+// Distance returns the great-circle distance between two places in kilometres
+func Distance(a, b Point) float64 {
+	return angularDistance(a, b) * EarthRadius
+}
+
+// angularDistance returns the central angle between two places in radians
+func angularDistance(a, b Point) float64 {
+	lat1, lat2 := position.DegreesToRadians(a.Latitude), position.DegreesToRadians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := position.DegreesToRadians(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// bearing returns the initial bearing from a to b in radians
+func bearing(a, b Point) float64 {
+	lat1, lat2 := position.DegreesToRadians(a.Latitude), position.DegreesToRadians(b.Latitude)
+	dLon := position.DegreesToRadians(b.Longitude - a.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Atan2(y, x)
+}
+
+// destination returns the place reached from a after an angular distance
+// along a bearing, both in radians
+func destination(a Point, dist, brg float64) Point {
+	lat1, lon1 := position.DegreesToRadians(a.Latitude), position.DegreesToRadians(a.Longitude)
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(dist) + math.Cos(lat1)*math.Sin(dist)*math.Cos(brg))
+	lon2 := lon1 + math.Atan2(math.Sin(brg)*math.Sin(dist)*math.Cos(lat1), math.Cos(dist)-math.Sin(lat1)*math.Sin(lat2))
+	return Point{Latitude: position.RadiansToDegrees(lat2), Longitude: normalizeLongitude(position.RadiansToDegrees(lon2))}
+}
+
+// segmentDistance returns the distance in kilometres from p to the
+// great-circle arc between a and b, and the closest point of the arc
+func segmentDistance(p, a, b Point) (float64, Point) {
+	d13 := angularDistance(a, p)
+	d12 := angularDistance(a, b)
+	if d12 == 0 {
+		return d13 * EarthRadius, a
+	}
+
+	// Cross-track and along-track distances from a
+	xt := math.Asin(math.Sin(d13) * math.Sin(bearing(a, p)-bearing(a, b)))
+	at := math.Acos(math.Max(-1, math.Min(1, math.Cos(d13)/math.Cos(xt))))
+	if math.Cos(bearing(a, p)-bearing(a, b)) < 0 {
+		at = -at
+	}
+
+	if at <= 0 {
+		return d13 * EarthRadius, a
+	}
+	if at >= d12 {
+		return angularDistance(b, p) * EarthRadius, b
+	}
+	return math.Abs(xt) * EarthRadius, destination(a, at, bearing(a, b))
+}
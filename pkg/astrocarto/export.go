@@ -0,0 +1,63 @@
+package astrocarto
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Export is a serializable set of lines with the lines near a place
+type Export struct {
+	Place  Point             `json:"place"`
+	Radius float64           `json:"radius_km"`
+	Near   []ProximityExport `json:"near"`
+	Lines  []LineExport      `json:"lines"`
+}
+
+// LineExport is the exported form of a line
+type LineExport struct {
+	Body     string    `json:"body"`
+	Angle    string    `json:"angle"`
+	Segments [][]Point `json:"segments"`
+}
+
+// ProximityExport is the exported form of a line near a place
+type ProximityExport struct {
+	Body     string  `json:"body"`
+	Angle    string  `json:"angle"`
+	Distance float64 `json:"distance_km"`
+	Nearest  Point   `json:"nearest"`
+}
+
+// NewExport builds the export of lines and of those within radius
+// kilometres of a place
+func NewExport(lines []Line, place Point, radius float64) Export {
+	e := Export{
+		Place:  place,
+		Radius: radius,
+		Near:   make([]ProximityExport, 0),
+		Lines:  make([]LineExport, 0, len(lines)),
+	}
+	for _, p := range Near(lines, place, radius) {
+		e.Near = append(e.Near, ProximityExport{
+			Body:     p.Line.Body.String(),
+			Angle:    p.Line.Angle.String(),
+			Distance: p.Distance,
+			Nearest:  p.Nearest,
+		})
+	}
+	for _, l := range lines {
+		e.Lines = append(e.Lines, LineExport{
+			Body:     l.Body.String(),
+			Angle:    l.Angle.String(),
+			Segments: l.Segments,
+		})
+	}
+	return e
+}
+
+// WriteJSON writes the export as indented JSON
+func (e Export) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
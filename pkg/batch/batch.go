@@ -0,0 +1,222 @@
+// Package batch builds complete charts from birth records, one at a time or
+// many at once across a bounded pool of workers.
+package batch
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/internal/house"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Record is a birth record to compute a chart for
+type Record struct {
+	ID        string
+	Name      string
+	DateTime  time.Time
+	Latitude  float64
+	Longitude float64
+	Location  string
+
+	// Line is the source line of the record (0 when not read from a file)
+	Line int
+	// Err is set when the record could not be read; it becomes its result
+	Err error
+}
+
+// Config selects what goes into each chart
+type Config struct {
+	Options position.Options
+	Orbs    horoscope.Orbs
+	StarOrb float64
+	Sky     bool // Observe rise, transit and set times (the slowest step)
+}
+
+// DefaultConfig matches the charts built by the TUI
+var DefaultConfig = Config{
+	Options: position.DefaultOptions,
+	Orbs:    horoscope.DefaultOrbs,
+	StarOrb: horoscope.DefaultStarOrb,
+	Sky:     true,
+}
+
+// Result is the outcome of one record
+type Result struct {
+	Index  int // Position of the record in the input
+	Record Record
+	Chart  *horoscope.Chart
+	Err    error
+}
+
+// NewChart builds the complete chart of a record: positions, houses,
+// aspects, calculated points, fixed star contacts and the local sky
+func NewChart(rec Record, cfg Config) (*horoscope.Chart, error) {
+	if err := rec.Validate(); err != nil {
+		return nil, err
+	}
+
+	positions := position.CalculateAllWithOptions(rec.DateTime, cfg.Options)
+	chart := &horoscope.Chart{
+		DateTime:  rec.DateTime,
+		Latitude:  rec.Latitude,
+		Longitude: rec.Longitude,
+		Location:  rec.Location,
+		Positions: positions,
+		Houses:    house.Calculate(rec.Latitude, rec.Longitude, rec.DateTime),
+		Aspects:   horoscope.CalculateAspects(positions, cfg.Orbs),
+	}
+	chart.AddCalculatedPoints()
+	chart.StarContacts = horoscope.CalculateStarContacts(chart, cfg.StarOrb)
+	if cfg.Sky {
+		chart.Sky = position.ObserveAll(rec.Latitude, rec.Longitude, rec.DateTime)
+	}
+	return chart, nil
+}
+
+// Relocate casts a chart for another place at the same instant. Positions
+// are geocentric and do not change; houses, angles, the points and parts
+// that depend on them, star parans and the local sky are recomputed.
+func Relocate(chart *horoscope.Chart, latitude, longitude float64, location string, cfg Config) (*horoscope.Chart, error) {
+	relocated, err := NewChart(Record{
+		DateTime:  chart.DateTime,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Location:  location,
+	}, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Relocating a relocated chart keeps the original birth place
+	relocated.Relocation = chart.Relocation
+	if relocated.Relocation == nil {
+		relocated.Relocation = &horoscope.Relocation{
+			Latitude:  chart.Latitude,
+			Longitude: chart.Longitude,
+			Location:  chart.Location,
+			Houses:    chart.Houses,
+		}
+	}
+	return relocated, nil
+}
+
+// Validate rejects records that cannot give a meaningful chart
+func (r Record) Validate() error {
+	switch {
+	case r.Err != nil:
+		return r.Err
+	case r.DateTime.IsZero():
+		return fmt.Errorf("missing birth date")
+	case r.Latitude < -90 || r.Latitude > 90:
+		return fmt.Errorf("latitude %g out of range", r.Latitude)
+	case r.Longitude < -180 || r.Longitude > 180:
+		return fmt.Errorf("longitude %g out of range", r.Longitude)
+	}
+	return nil
+}
+
+// Run computes the charts of all records and returns the results in input
+// order. Records not started before ctx is cancelled get ctx's error.
+func Run(ctx context.Context, records []Record, cfg Config, workers int) []Result {
+	in := make(chan Record)
+	go func() {
+		defer close(in)
+		for _, rec := range records {
+			select {
+			case in <- rec:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make([]Result, len(records))
+	done := make([]bool, len(records))
+	for res := range Stream(ctx, in, cfg, workers) {
+		results[res.Index] = res
+		done[res.Index] = true
+	}
+	for i, ok := range done {
+		if !ok {
+			results[i] = Result{Index: i, Record: records[i], Err: ctx.Err()}
+		}
+	}
+	return results
+}
+
+// Stream computes a chart for each record received on in, using at most
+// workers goroutines (the number of CPUs when workers < 1). Results arrive
+// in completion order; the channel closes once in is drained or ctx is done.
+func Stream(ctx context.Context, in <-chan Record, cfg Config, workers int) <-chan Result {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	type job struct {
+		index int
+		rec   Record
+	}
+	jobs := make(chan job)
+	out := make(chan Result)
+
+	go func() {
+		defer close(jobs)
+		index := 0
+		for {
+			select {
+			case rec, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- job{index, rec}:
+					index++
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				res := compute(j.index, j.rec, cfg)
+				select {
+				case out <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// compute builds one chart, turning a panic into the record's error so
+// that one bad record cannot stop the batch
+func compute(index int, rec Record, cfg Config) (res Result) {
+	res = Result{Index: index, Record: rec}
+	defer func() {
+		if r := recover(); r != nil {
+			res.Chart, res.Err = nil, fmt.Errorf("chart computation failed: %v", r)
+		}
+	}()
+	res.Chart, res.Err = NewChart(rec, cfg)
+	return res
+}
@@ -0,0 +1,151 @@
+package batch
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+)
+
+// CSV columns. Only date, latitude and longitude are required; time
+// defaults to noon and timezone to the local zone.
+const (
+	ColumnID        = "id"
+	ColumnName      = "name"
+	ColumnDate      = "date"      // YYYY-MM-DD
+	ColumnTime      = "time"      // HH:MM or HH:MM:SS
+	ColumnTimezone  = "timezone"  // IANA name, e.g. Europe/Paris
+	ColumnLatitude  = "latitude"  // decimal degrees, north positive
+	ColumnLongitude = "longitude" // decimal degrees, east positive
+	ColumnLocation  = "location"
+)
+
+// ReadCSV reads birth records from CSV with a header row naming the columns.
+// Rows that cannot be parsed are returned with Err set so that they are
+// reported in place; only an unreadable header fails the whole file.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{ColumnDate, ColumnLatitude, ColumnLongitude} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header is missing the %q column", required)
+		}
+	}
+
+	var records []Record
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// FieldPos has no fields to report after a failed read
+			var line int
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				line = pe.StartLine
+			}
+			records = append(records, Record{Line: line, Err: err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		rec := Record{
+			ID:       get(ColumnID),
+			Name:     get(ColumnName),
+			Location: get(ColumnLocation),
+			Line:     line,
+		}
+		rec.DateTime, rec.Latitude, rec.Longitude, rec.Err = parseRow(get)
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// parseRow reads the date, time, timezone and coordinates of a row
+func parseRow(get func(string) string) (time.Time, float64, float64, error) {
+	loc := time.Local
+	if tz := get(ColumnTimezone); tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return time.Time{}, 0, 0, fmt.Errorf("invalid timezone %q", tz)
+		}
+	}
+
+	clock := get(ColumnTime)
+	if clock == "" {
+		clock = "12:00"
+	}
+	layout := "2006-01-02 15:04"
+	if strings.Count(clock, ":") == 2 {
+		layout += ":05"
+	}
+	dateTime, err := time.ParseInLocation(layout, get(ColumnDate)+" "+clock, loc)
+	if err != nil {
+		return time.Time{}, 0, 0, fmt.Errorf("invalid date or time %q %q", get(ColumnDate), clock)
+	}
+
+	lat, err := strconv.ParseFloat(get(ColumnLatitude), 64)
+	if err != nil {
+		return time.Time{}, 0, 0, fmt.Errorf("invalid latitude %q", get(ColumnLatitude))
+	}
+	lon, err := strconv.ParseFloat(get(ColumnLongitude), 64)
+	if err != nil {
+		return time.Time{}, 0, 0, fmt.Errorf("invalid longitude %q", get(ColumnLongitude))
+	}
+	return dateTime, lat, lon, nil
+}
+
+// Line is one JSON Lines record: the chart export, or the record's error
+type Line struct {
+	ID    string            `json:"id,omitempty"`
+	Name  string            `json:"name,omitempty"`
+	Line  int               `json:"line,omitempty"`
+	Chart *horoscope.Export `json:"chart,omitempty"`
+	Error string            `json:"error,omitempty"`
+}
+
+// WriteJSONL writes one JSON object per result
+func WriteJSONL(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	for _, res := range results {
+		if err := enc.Encode(res.Line()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Line converts a result to its JSON Lines form
+func (r Result) Line() Line {
+	l := Line{ID: r.Record.ID, Name: r.Record.Name, Line: r.Record.Line}
+	if r.Err != nil {
+		l.Error = r.Err.Error()
+		return l
+	}
+	export := r.Chart.Export()
+	l.Chart = &export
+	return l
+}
@@ -0,0 +1,41 @@
+package batch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadCSVMalformedRows(t *testing.T) {
+	input := "id,date,time,timezone,latitude,longitude\n" +
+		"a,1990-01-01,12:00,UTC,48.85,2.35\n" +
+		"\"b\"c,1990-01-01,12:00,UTC,48.85,2.35\n" +
+		"d,1990-01-01,12:00,UTC\n" +
+		"e,1990-01-01,12:00,UTC,48.85,2.35,extra\n"
+
+	records, err := ReadCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadCSV: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+
+	tests := []struct {
+		line  int
+		fails bool
+	}{
+		{line: 2},
+		{line: 3, fails: true}, // bad quote
+		{line: 4, fails: true}, // no coordinates
+		{line: 5},              // extra fields are ignored
+	}
+	for i, tt := range tests {
+		rec := records[i]
+		if rec.Line != tt.line {
+			t.Errorf("record %d reported on line %d, want %d", i, rec.Line, tt.line)
+		}
+		if tt.fails != (rec.Err != nil) {
+			t.Errorf("line %d: error %v, want failure %v", tt.line, rec.Err, tt.fails)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package bazi
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Chart holds the four pillars of a birth
+type Chart struct {
+	Birth     time.Time
+	Longitude float64   // Longitude of the birth place, which sets the solar time
+	SolarTime time.Time // Local mean solar time of the birth, used for the day and hour
+	Year      Pillar
+	Month     Pillar
+	Day       Pillar
+	Hour      Pillar
+}
+
+// Pillars returns the year, month, day and hour pillars in order
+func (c Chart) Pillars() [4]Pillar {
+	return [4]Pillar{c.Year, c.Month, c.Day, c.Hour}
+}
+
+// DayMaster returns the stem of the day, which stands for the native
+func (c Chart) DayMaster() Stem {
+	return c.Day.Stem
+}
+
+// Animal returns the zodiac animal of the year
+func (c Chart) Animal() string {
+	return c.Year.Branch.Animal()
+}
+
+// Solar terms: the month begins when the Sun reaches 15° of a sign, the
+// first month (Tiger) at 15° Aquarius, the start of spring (Lichun)
+const (
+	springLongitude = 315.0
+	termSpan        = 30.0
+)
+
+// dayZero is a day whose pillar is known: 1 January 2000 is Wu Wu
+var dayZero = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const dayZeroIndex = 54
+
+// Calculate returns the four pillars of a birth at a place. The year and
+// month change at the solar terms; the day and hour follow the local mean
+// solar time of the birth place, the day changing at 23:00 with the start
+// of the Rat hour. The solar terms are found to within a quarter of an
+// hour, the precision of the Sun's position.
+func Calculate(birth time.Time, longitude float64) Chart {
+	c := Chart{Birth: birth, Longitude: longitude}
+	c.SolarTime = solarTime(birth, longitude)
+
+	month := solarMonth(birth)
+	year := birth.UTC().Year()
+	// January and the start of February belong to the previous solar year
+	if birth.UTC().Month() <= time.February && month >= 10 {
+		year--
+	}
+	c.Year = PillarAt(year - 4)
+
+	// Five Tigers rule: the stem of the first month follows the year stem
+	firstStem := (int(c.Year.Stem)%5)*2 + 2
+	c.Month = Pillar{Stem: Stem((firstStem + month) % 10), Branch: Branch((month + 2) % 12)}
+
+	day := time.Date(c.SolarTime.Year(), c.SolarTime.Month(), c.SolarTime.Day(), 0, 0, 0, 0, time.UTC)
+	if c.SolarTime.Hour() >= 23 {
+		day = day.AddDate(0, 0, 1)
+	}
+	days := int(math.Round(day.Sub(dayZero).Hours() / 24))
+	c.Day = PillarAt(dayZeroIndex + days)
+
+	// Five Rats rule: the stem of the Rat hour follows the day stem
+	branch := (c.SolarTime.Hour() + 1) / 2 % 12
+	ratStem := (int(c.Day.Stem) % 5) * 2
+	c.Hour = Pillar{Stem: Stem((ratStem + branch) % 10), Branch: Branch(branch)}
+	return c
+}
+
+// solarTime returns the local mean solar time at a longitude, as a UTC
+// clock reading
+func solarTime(t time.Time, longitude float64) time.Time {
+	return t.UTC().Add(time.Duration(longitude / 15 * float64(time.Hour)))
+}
+
+// solarMonth returns the solar month running at t, 0 for the Tiger month
+// starting at Lichun
+func solarMonth(t time.Time) int {
+	lon := position.Calculate(position.Sun, t).EclipticLongitude
+	return int(position.NormalizeAngle(lon-springLongitude) / termSpan)
+}
+
+// sunDegreesPerDay is the mean motion of the Sun
+const sunDegreesPerDay = 0.9856
+
+// termBefore returns the start of the solar month running at t
+func termBefore(t time.Time) time.Time {
+	lon := position.Calculate(position.Sun, t).EclipticLongitude
+	elapsed := math.Mod(position.NormalizeAngle(lon-springLongitude), termSpan)
+	target := position.NormalizeAngle(lon - elapsed)
+	return sunReaches(target, t.Add(-days(elapsed/sunDegreesPerDay)))
+}
+
+// termAfter returns the start of the next solar month after t
+func termAfter(t time.Time) time.Time {
+	lon := position.Calculate(position.Sun, t).EclipticLongitude
+	left := termSpan - math.Mod(position.NormalizeAngle(lon-springLongitude), termSpan)
+	target := position.NormalizeAngle(lon + left)
+	return sunReaches(target, t.Add(days(left/sunDegreesPerDay)))
+}
+
+// sunReaches refines the time at which the Sun reaches a longitude from a
+// first guess within a few days of it
+func sunReaches(longitude float64, guess time.Time) time.Time {
+	t := guess
+	for range 6 {
+		lon := position.Calculate(position.Sun, t).EclipticLongitude
+		diff := position.NormalizeAngle(longitude-lon+180) - 180
+		t = t.Add(days(diff / sunDegreesPerDay))
+		if math.Abs(diff) < 1e-6 {
+			break
+		}
+	}
+	return t
+}
+
+func days(d float64) time.Duration {
+	return time.Duration(d * 24 * float64(time.Hour))
+}
+
+// Sex selects the direction of the luck pillars
+type Sex int
+
+// Sexes of the native; the luck pillars are unknown without one.
+const (
+	Unknown Sex = iota
+	Female
+	Male
+)
+
+// String returns the name of the sex
+func (s Sex) String() string {
+	return []string{"Unknown", "Female", "Male"}[s]
+}
+
+// ParseSex reads "female" or "male", or their initial, ignoring case. An
+// empty string is Unknown.
+func ParseSex(s string) (Sex, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return Unknown, nil
+	case "f", "female":
+		return Female, nil
+	case "m", "male":
+		return Male, nil
+	}
+	return Unknown, fmt.Errorf("unknown sex %q (female or male)", s)
+}
+
+// LuckPillar is a ten-year period of life ruled by a pillar
+type LuckPillar struct {
+	Pillar
+	Age   float64 // Age in years at its start
+	Start time.Time
+	End   time.Time
+}
+
+// LuckPillarCount is the number of luck pillars usually read, covering
+// about eighty years
+const LuckPillarCount = 8
+
+// luckYears is the length of a luck pillar
+const luckYears = 10
+
+// yearDays is the length of the tropical year
+const yearDays = 365.2422
+
+// Forward reports whether the luck pillars of a native run forward in the
+// cycle: for yang years in men and yin years in women
+func (c Chart) Forward(sex Sex) bool {
+	return c.Year.Stem.Yang() == (sex == Male)
+}
+
+// LuckPillars returns the luck pillars following the month pillar. The
+// first one starts after as many years as there are days, divided by
+// three, between the birth and the next solar term, or the previous one
+// when the pillars run backward. There are none for an Unknown sex.
+func (c Chart) LuckPillars(sex Sex, count int) []LuckPillar {
+	if sex == Unknown {
+		return nil
+	}
+	step := 1
+	var span time.Duration
+	if c.Forward(sex) {
+		span = termAfter(c.Birth).Sub(c.Birth)
+	} else {
+		step = -1
+		span = c.Birth.Sub(termBefore(c.Birth))
+	}
+
+	age := span.Hours() / 24 / 3
+	pillars := make([]LuckPillar, count)
+	for i := range pillars {
+		start := age + float64(i*luckYears)
+		pillars[i] = LuckPillar{
+			Pillar: c.Month.Next(step * (i + 1)),
+			Age:    start,
+			Start:  c.Birth.Add(days(start * yearDays)),
+			End:    c.Birth.Add(days((start + luckYears) * yearDays)),
+		}
+	}
+	return pillars
+}
+
+// LuckPillarAt returns the luck pillar running at t, if any
+func LuckPillarAt(pillars []LuckPillar, t time.Time) (LuckPillar, bool) {
+	for _, p := range pillars {
+		if !t.Before(p.Start) && t.Before(p.End) {
+			return p, true
+		}
+	}
+	return LuckPillar{}, false
+}
@@ -0,0 +1,88 @@
+package bazi
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Export is a serializable BaZi chart with its luck pillars
+type Export struct {
+	Birth     time.Time      `json:"birth"`
+	Longitude float64        `json:"longitude"`
+	SolarTime string         `json:"solar_time"`
+	Animal    string         `json:"animal"`
+	DayMaster string         `json:"day_master"`
+	Pillars   []PillarExport `json:"pillars"`
+	Sex       string         `json:"sex,omitempty"`
+	Forward   *bool          `json:"forward,omitempty"`
+	Luck      []LuckExport   `json:"luck_pillars,omitempty"`
+}
+
+// PillarExport is the exported form of a pillar
+type PillarExport struct {
+	Name          string `json:"name,omitempty"`
+	Stem          string `json:"stem"`
+	Branch        string `json:"branch"`
+	Hanzi         string `json:"hanzi"`
+	StemElement   string `json:"stem_element"`
+	Polarity      string `json:"polarity"`
+	BranchElement string `json:"branch_element"`
+	Animal        string `json:"animal"`
+}
+
+// LuckExport is the exported form of a luck pillar
+type LuckExport struct {
+	PillarExport
+	Age   float64   `json:"age"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// PillarNames are the names of the four pillars, in order
+var PillarNames = [4]string{"Year", "Month", "Day", "Hour"}
+
+// NewExport builds the export of a chart and, when the sex is known, of
+// its luck pillars
+func NewExport(c Chart, sex Sex) Export {
+	e := Export{
+		Birth:     c.Birth,
+		Longitude: c.Longitude,
+		SolarTime: c.SolarTime.Format("2006-01-02 15:04"),
+		Animal:    c.Animal(),
+		DayMaster: c.DayMaster().String(),
+	}
+	for i, p := range c.Pillars() {
+		pe := exportPillar(p)
+		pe.Name = PillarNames[i]
+		e.Pillars = append(e.Pillars, pe)
+	}
+	if sex != Unknown {
+		forward := c.Forward(sex)
+		e.Sex = sex.String()
+		e.Forward = &forward
+		for _, l := range c.LuckPillars(sex, LuckPillarCount) {
+			e.Luck = append(e.Luck, LuckExport{PillarExport: exportPillar(l.Pillar), Age: l.Age, Start: l.Start, End: l.End})
+		}
+	}
+	return e
+}
+
+func exportPillar(p Pillar) PillarExport {
+	return PillarExport{
+		Stem:          p.Stem.String(),
+		Branch:        p.Branch.String(),
+		Hanzi:         p.Hanzi(),
+		StemElement:   p.Stem.Element().String(),
+		Polarity:      p.Stem.Polarity(),
+		BranchElement: p.Branch.Element().String(),
+		Animal:        p.Branch.Animal(),
+	}
+}
+
+// WriteJSON writes the export as indented JSON
+func (e Export) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
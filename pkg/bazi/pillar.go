@@ -0,0 +1,155 @@
+// Package bazi computes the Four Pillars of Destiny (BaZi) of Chinese
+// astrology: the sexagenary year, month, day and hour of birth, and the
+// ten-year luck pillars that follow from the month.
+package bazi
+
+import "fmt"
+
+// Element is one of the five phases
+type Element int
+
+// The five phases, in their generating order.
+const (
+	Wood Element = iota
+	Fire
+	Earth
+	Metal
+	Water
+)
+
+// String returns the name of the element
+func (e Element) String() string {
+	return []string{"Wood", "Fire", "Earth", "Metal", "Water"}[e]
+}
+
+// Stem is one of the ten heavenly stems
+type Stem int
+
+// The ten heavenly stems.
+const (
+	Jia Stem = iota
+	Yi
+	Bing
+	Ding
+	Wu
+	Ji
+	Geng
+	Xin
+	Ren
+	Gui
+)
+
+var stemNames = [10]string{"Jia", "Yi", "Bing", "Ding", "Wu", "Ji", "Geng", "Xin", "Ren", "Gui"}
+var stemHanzi = [10]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+
+// String returns the pinyin name of the stem
+func (s Stem) String() string {
+	return stemNames[s]
+}
+
+// Hanzi returns the Chinese character of the stem
+func (s Stem) Hanzi() string {
+	return stemHanzi[s]
+}
+
+// Element returns the element of the stem; stems go by pairs
+func (s Stem) Element() Element {
+	return Element(s / 2)
+}
+
+// Yang reports whether the stem is yang, which every other stem is
+func (s Stem) Yang() bool {
+	return s%2 == 0
+}
+
+// Polarity returns "Yang" or "Yin"
+func (s Stem) Polarity() string {
+	if s.Yang() {
+		return "Yang"
+	}
+	return "Yin"
+}
+
+// Branch is one of the twelve earthly branches
+type Branch int
+
+// The twelve earthly branches, named after their animals (Zi, Chou, Yin,
+// Mao, Chen, Si, Wu, Wei, Shen, You, Xu and Hai).
+const (
+	Rat Branch = iota
+	Ox
+	Tiger
+	Rabbit
+	Dragon
+	Snake
+	Horse
+	Goat
+	Monkey
+	Rooster
+	Dog
+	Pig
+)
+
+var branchNames = [12]string{"Zi", "Chou", "Yin", "Mao", "Chen", "Si", "Wu", "Wei", "Shen", "You", "Xu", "Hai"}
+var branchHanzi = [12]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+var branchAnimals = [12]string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"}
+var branchElements = [12]Element{Water, Earth, Wood, Wood, Earth, Fire, Fire, Earth, Metal, Metal, Earth, Water}
+
+// String returns the pinyin name of the branch
+func (b Branch) String() string {
+	return branchNames[b]
+}
+
+// Hanzi returns the Chinese character of the branch
+func (b Branch) Hanzi() string {
+	return branchHanzi[b]
+}
+
+// Animal returns the zodiac animal of the branch
+func (b Branch) Animal() string {
+	return branchAnimals[b]
+}
+
+// Element returns the element of the branch
+func (b Branch) Element() Element {
+	return branchElements[b]
+}
+
+// Pillar is a stem and branch pair, one of the sixty of the sexagenary cycle
+type Pillar struct {
+	Stem   Stem
+	Branch Branch
+}
+
+// PillarAt returns the pillar at a position of the sexagenary cycle, Jia
+// Zi being 0. Positions wrap around the cycle.
+func PillarAt(index int) Pillar {
+	index = ((index % 60) + 60) % 60
+	return Pillar{Stem: Stem(index % 10), Branch: Branch(index % 12)}
+}
+
+// Index returns the position of the pillar in the sexagenary cycle
+func (p Pillar) Index() int {
+	// The index i satisfies i ≡ stem (mod 10) and i ≡ branch (mod 12)
+	for i := int(p.Stem); i < 60; i += 10 {
+		if i%12 == int(p.Branch) {
+			return i
+		}
+	}
+	return 0
+}
+
+// Next returns the pillar n steps further in the cycle, or back if n < 0
+func (p Pillar) Next(n int) Pillar {
+	return PillarAt(p.Index() + n)
+}
+
+// String returns the pinyin of the pillar, such as "Jia Zi"
+func (p Pillar) String() string {
+	return fmt.Sprintf("%s %s", p.Stem, p.Branch)
+}
+
+// Hanzi returns the Chinese characters of the pillar, such as "甲子"
+func (p Pillar) Hanzi() string {
+	return p.Stem.Hanzi() + p.Branch.Hanzi()
+}
@@ -0,0 +1,214 @@
+package election
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Criterion is one condition of an election
+type Criterion interface {
+	// Match reports whether the moment satisfies the criterion, with a
+	// score from 0 to 1 rating how well
+	Match(m *Moment) (bool, float64)
+	// String describes the criterion in the syntax of Parse
+	String() string
+}
+
+// Waxing matches a waxing Moon, from the New to the Full Moon. The score
+// peaks at the Full Moon.
+func Waxing() Criterion { return phase{waxing: true} }
+
+// Waning matches a waning Moon, from the Full to the New Moon. The score
+// peaks at the New Moon.
+func Waning() Criterion { return phase{waxing: false} }
+
+type phase struct {
+	waxing bool
+}
+
+func (c phase) Match(m *Moment) (bool, float64) {
+	sun, moon := m.Position(position.Sun), m.Position(position.Moon)
+	if sun == nil || moon == nil {
+		return false, 0
+	}
+	elongation := position.NormalizeAngle(moon.EclipticLongitude - sun.EclipticLongitude)
+	if c.waxing {
+		return elongation < 180, elongation / 180
+	}
+	return elongation >= 180, (elongation - 180) / 180
+}
+
+func (c phase) String() string {
+	if c.waxing {
+		return "moon waxing"
+	}
+	return "moon waning"
+}
+
+// VoidOfCourse matches a void-of-course Moon (see VoidPeriods)
+func VoidOfCourse() Criterion { return void{} }
+
+type void struct{}
+
+func (void) Match(m *Moment) (bool, float64) { return m.Void, 1 }
+func (void) String() string                  { return "moon void" }
+
+// Not negates a criterion. Negated criteria score 1 when they match.
+func Not(c Criterion) Criterion { return not{c} }
+
+type not struct {
+	c Criterion
+}
+
+func (c not) Match(m *Moment) (bool, float64) {
+	ok, _ := c.c.Match(m)
+	return !ok, 1
+}
+
+func (c not) String() string { return "not " + c.c.String() }
+
+// InSign matches a body in a sign
+func InSign(body position.CelestialBody, sign horoscope.ZodiacSign) Criterion {
+	return placement{body: body, name: sign.String(), match: func(s horoscope.ZodiacSign) bool { return s == sign }}
+}
+
+// InElement matches a body in a sign of an element
+func InElement(body position.CelestialBody, element horoscope.Element) Criterion {
+	return placement{body: body, name: element.String(), match: func(s horoscope.ZodiacSign) bool { return s.Element() == element }}
+}
+
+// InModality matches a body in a sign of a modality
+func InModality(body position.CelestialBody, modality horoscope.Modality) Criterion {
+	return placement{body: body, name: modality.String(), match: func(s horoscope.ZodiacSign) bool { return s.Modality() == modality }}
+}
+
+type placement struct {
+	body  position.CelestialBody
+	name  string
+	match func(horoscope.ZodiacSign) bool
+}
+
+func (c placement) Match(m *Moment) (bool, float64) {
+	pos := m.Position(c.body)
+	if pos == nil {
+		return false, 0
+	}
+	return c.match(horoscope.LongitudeToZodiac(pos.EclipticLongitude).Sign), 1
+}
+
+func (c placement) String() string {
+	return fmt.Sprintf("%s in %s", lower(c.body.String()), lower(c.name))
+}
+
+// Motion is the apparent direction of a body
+type Motion int
+
+// Apparent motions.
+const (
+	Direct Motion = iota
+	Retrograde
+	Stationary
+)
+
+var motionNames = map[Motion]string{
+	Direct:     "direct",
+	Retrograde: "retrograde",
+	Stationary: "stationary",
+}
+
+// Moving matches a body in direct, retrograde or stationary motion. A
+// stationary body is also direct or retrograde.
+func Moving(body position.CelestialBody, motion Motion) Criterion {
+	return moving{body: body, motion: motion}
+}
+
+type moving struct {
+	body   position.CelestialBody
+	motion Motion
+}
+
+func (c moving) Match(m *Moment) (bool, float64) {
+	pos := m.Position(c.body)
+	if pos == nil {
+		return false, 0
+	}
+	switch c.motion {
+	case Retrograde:
+		return pos.Retrograde, 1
+	case Stationary:
+		return pos.Stationary, 1
+	default:
+		return !pos.Retrograde, 1
+	}
+}
+
+func (c moving) String() string {
+	return fmt.Sprintf("%s %s", lower(c.body.String()), motionNames[c.motion])
+}
+
+// Aspect matches a body within orb of an aspect to another transiting
+// body, or to a natal body when natal is set. The score is 1 when the
+// aspect is exact and falls to 0 at the edge of the orb.
+func Aspect(body position.CelestialBody, aspect horoscope.AspectType, target position.CelestialBody, natal bool, orb float64) Criterion {
+	return aspectTo{body: body, aspect: aspect, target: target, natal: natal, orb: orb}
+}
+
+type aspectTo struct {
+	body   position.CelestialBody
+	aspect horoscope.AspectType
+	target position.CelestialBody
+	natal  bool
+	orb    float64
+}
+
+func (c aspectTo) Match(m *Moment) (bool, float64) {
+	p := m.Position(c.body)
+	var t *position.Position
+	if c.natal {
+		if m.Natal != nil {
+			t = m.Natal.GetPosition(c.target)
+		}
+	} else {
+		t = m.Position(c.target)
+	}
+	if p == nil || t == nil || c.orb <= 0 {
+		return false, 0
+	}
+
+	var orb float64
+	switch c.aspect {
+	case horoscope.Parallel:
+		orb = math.Abs(p.Declination - t.Declination)
+	case horoscope.ContraParallel:
+		orb = math.Abs(p.Declination + t.Declination)
+	default:
+		sep := math.Abs(position.NormalizeAngle(p.EclipticLongitude-t.EclipticLongitude+180) - 180)
+		orb = math.Abs(sep - c.aspect.Angle())
+	}
+	if orb > c.orb {
+		return false, 0
+	}
+	return true, 1 - orb/c.orb
+}
+
+func (c aspectTo) String() string {
+	target := lower(c.target.String())
+	if c.natal {
+		target = "natal " + target
+	}
+	return fmt.Sprintf("%s %s %s orb %g", lower(c.body.String()), aspectWords[c.aspect], target, c.orb)
+}
+
+// needsNatal reports whether a criterion refers to the natal chart
+func needsNatal(c Criterion) bool {
+	switch c := c.(type) {
+	case aspectTo:
+		return c.natal
+	case not:
+		return needsNatal(c.c)
+	}
+	return false
+}
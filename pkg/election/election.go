@@ -0,0 +1,178 @@
+// Package election searches the ephemeris for moments that satisfy a set
+// of electional criteria, such as a waxing Moon in an earth sign with
+// Venus trine the natal Sun, and ranks the windows in which they all hold.
+package election
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/ephemeris"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Moment is the state of the sky at one step of a search
+type Moment struct {
+	Time      time.Time
+	Positions []position.Position
+	Void      bool             // Moon void of course
+	Natal     *horoscope.Chart // Nil unless given in the Config
+}
+
+// Position returns the position of a body at the moment, or nil
+func (m *Moment) Position(body position.CelestialBody) *position.Position {
+	for i := range m.Positions {
+		if m.Positions[i].Body == body {
+			return &m.Positions[i]
+		}
+	}
+	return nil
+}
+
+// Config holds the settings of a search
+type Config struct {
+	Step    time.Duration    // Interval between the tested moments
+	Natal   *horoscope.Chart // Chart of the "natal" criteria
+	Options position.Options // Node and Lilith models
+	Limit   int              // Maximum number of windows returned, 0 for all
+}
+
+// DefaultConfig tests every hour and returns the ten best windows
+var DefaultConfig = Config{
+	Step:    time.Hour,
+	Options: position.DefaultOptions,
+	Limit:   10,
+}
+
+// Window is a span of time during which every criterion holds
+type Window struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"` // Last matching step
+	Peak  time.Time `json:"peak"`
+	Score float64   `json:"score"` // Mean score of the criteria at the peak
+}
+
+// Duration returns the length of the window
+func (w Window) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// NeedsNatal reports whether any criterion refers to the natal chart
+func NeedsNatal(criteria []Criterion) bool {
+	for _, c := range criteria {
+		if needsNatal(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Search tests the moments from one time to another at the configured
+// step and returns the windows during which every criterion holds, best
+// first: by score at their peak, then by length.
+func Search(criteria []Criterion, from, to time.Time, cfg Config) ([]Window, error) {
+	switch {
+	case len(criteria) == 0:
+		return nil, fmt.Errorf("no criteria")
+	case cfg.Step <= 0:
+		return nil, fmt.Errorf("step must be positive")
+	case !to.After(from):
+		return nil, fmt.Errorf("the search must end after it starts")
+	case cfg.Natal == nil && NeedsNatal(criteria):
+		return nil, fmt.Errorf("natal criteria need a natal chart")
+	}
+
+	// The positions are interpolated from a Chebyshev cache of the range,
+	// extended for the Moon's void-of-course periods at its edges. A cache
+	// that could not be saved is still complete, so saving is best effort.
+	cache, _ := ephemeris.Open(from.Add(-voidMargin), to.Add(voidMargin), cfg.Options)
+
+	var voids []Period
+	if needsVoid(criteria) {
+		voids = voidPeriods(from, to, cache.PositionAtDay)
+	}
+
+	var windows []Window
+	var current *Window
+	for t := from; !t.After(to); t = t.Add(cfg.Step) {
+		m := &Moment{
+			Time:      t,
+			Positions: cache.CalculateAll(t),
+			Natal:     cfg.Natal,
+		}
+		for _, p := range voids {
+			if p.Contains(t) {
+				m.Void = true
+				break
+			}
+		}
+
+		ok, score := matchAll(criteria, m)
+		if !ok {
+			if current != nil {
+				windows = append(windows, *current)
+				current = nil
+			}
+			continue
+		}
+		if current == nil {
+			current = &Window{Start: t, Peak: t, Score: score}
+		}
+		current.End = t
+		if score > current.Score {
+			current.Peak, current.Score = t, score
+		}
+	}
+	if current != nil {
+		windows = append(windows, *current)
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool {
+		if windows[i].Score != windows[j].Score {
+			return windows[i].Score > windows[j].Score
+		}
+		return windows[i].Duration() > windows[j].Duration()
+	})
+	if cfg.Limit > 0 && len(windows) > cfg.Limit {
+		windows = windows[:cfg.Limit]
+	}
+	return windows, nil
+}
+
+// matchAll tests every criterion and returns their mean score
+func matchAll(criteria []Criterion, m *Moment) (bool, float64) {
+	total := 0.0
+	for _, c := range criteria {
+		ok, score := c.Match(m)
+		if !ok {
+			return false, 0
+		}
+		total += score
+	}
+	return true, total / float64(len(criteria))
+}
+
+// needsVoid reports whether the void-of-course periods must be computed
+func needsVoid(criteria []Criterion) bool {
+	for _, c := range criteria {
+		if n, ok := c.(not); ok {
+			c = n.c
+		}
+		if _, ok := c.(void); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe formats criteria in the syntax of Parse
+func Describe(criteria []Criterion) string {
+	parts := make([]string, len(criteria))
+	for i, c := range criteria {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, ", ")
+}
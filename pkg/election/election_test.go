@@ -0,0 +1,127 @@
+package election
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+func TestParseDescribeRoundTrip(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Moon waxing; moon is not void and moon in earth", "moon waxing, not moon void, moon in earth"},
+		{"mercury direct, not mars in aries, sun is not retrograde", "mercury direct, not mars in aries, not sun retrograde"},
+		{"venus trine natal sun orb 2, jupiter squares saturn", "venus trine natal sun orb 2, jupiter square saturn orb 5"},
+		{"moon in cardinal and mars stationary", "moon in cardinal, mars stationary"},
+		{"moon parallel venus, north node in leo", "moon parallel venus orb 0.5, north node in leo"},
+	}
+	for _, tt := range tests {
+		criteria, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.input, err)
+			continue
+		}
+		got := Describe(criteria)
+		if got != tt.want {
+			t.Errorf("Describe(Parse(%q)) = %q, want %q", tt.input, got, tt.want)
+		}
+
+		again, err := Parse(got)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", got, err)
+			continue
+		}
+		if d := Describe(again); d != got {
+			t.Errorf("%q described again as %q", got, d)
+		}
+	}
+}
+
+// bruteForceVoid reports whether the Moon is void of course at each
+// sample: scanning forward minute by minute, it enters the next sign
+// before perfecting any Ptolemaic aspect to the Sun or a planet
+func bruteForceVoid(from, to time.Time, every time.Duration) []bool {
+	const step = time.Minute
+	end := to.Add(voidMargin)
+	n := int(end.Sub(from)/step) + 1
+	moon := make([]float64, n)
+	bodies := make([][]float64, n)
+	for k := range n {
+		d := position.DayNumber(from.Add(time.Duration(k) * step))
+		moon[k] = position.CalculateAtDay(position.Moon, d).EclipticLongitude
+		bodies[k] = make([]float64, len(voidBodies))
+		for i, body := range voidBodies {
+			bodies[k][i] = position.CalculateAtDay(body, d).EclipticLongitude
+		}
+	}
+
+	// perfects reports whether an aspect becomes exact between minutes k-1 and k
+	perfects := func(k int) bool {
+		for i := range voidBodies {
+			for _, angle := range voidAspects {
+				d1 := position.NormalizeAngle(moon[k-1]-bodies[k-1][i]-angle+180) - 180
+				d2 := position.NormalizeAngle(moon[k]-bodies[k][i]-angle+180) - 180
+				if d1 < 0 && d2 >= 0 {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	var void []bool
+	for t := from; t.Before(to); t = t.Add(every) {
+		k := int(t.Sub(from) / step)
+		v := true
+		for j := k + 1; j < n && sign(moon[j]) == sign(moon[k]); j++ {
+			if perfects(j) {
+				v = false
+				break
+			}
+		}
+		void = append(void, v)
+	}
+	return void
+}
+
+func TestVoidPeriodsBruteForce(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	const every = 17 * time.Minute
+	const tolerance = 10 * time.Minute
+
+	periods := VoidPeriods(from, to)
+	want := bruteForceVoid(from, to, every)
+
+	nearEdge := func(at time.Time) bool {
+		for _, p := range periods {
+			if at.Sub(p.Start).Abs() < tolerance || at.Sub(p.End).Abs() < tolerance {
+				return true
+			}
+		}
+		return false
+	}
+
+	var voids int
+	for i, at := 0, from; at.Before(to); i, at = i+1, at.Add(every) {
+		got := false
+		for _, p := range periods {
+			if p.Contains(at) {
+				got = true
+				break
+			}
+		}
+		if got {
+			voids++
+		}
+		if got != want[i] && !nearEdge(at) {
+			t.Errorf("%s: void %v, brute force %v", at.Format(time.RFC3339), got, want[i])
+		}
+	}
+	if voids == 0 {
+		t.Error("no void-of-course period found in a month")
+	}
+}
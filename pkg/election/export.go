@@ -0,0 +1,40 @@
+package election
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Export is a serializable search with its windows
+type Export struct {
+	Criteria []string `json:"criteria"`
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Step     string   `json:"step"`
+	Windows  []Window `json:"windows"`
+}
+
+// NewExport builds the export of a search
+func NewExport(criteria []Criterion, from, to time.Time, step time.Duration, windows []Window) Export {
+	e := Export{
+		From:    from.Format(time.RFC3339),
+		To:      to.Format(time.RFC3339),
+		Step:    step.String(),
+		Windows: windows,
+	}
+	for _, c := range criteria {
+		e.Criteria = append(e.Criteria, c.String())
+	}
+	if e.Windows == nil {
+		e.Windows = make([]Window, 0)
+	}
+	return e
+}
+
+// WriteJSON writes the export as indented JSON
+func (e Export) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
@@ -0,0 +1,236 @@
+package election
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// aspectWords name the aspects in criteria
+var aspectWords = map[horoscope.AspectType]string{
+	horoscope.Conjunction:    "conjunct",
+	horoscope.Sextile:        "sextile",
+	horoscope.Square:         "square",
+	horoscope.Trine:          "trine",
+	horoscope.Opposition:     "opposite",
+	horoscope.Quincunx:       "quincunx",
+	horoscope.Parallel:       "parallel",
+	horoscope.ContraParallel: "contraparallel",
+}
+
+// aspectAliases are the other accepted spellings of aspects
+var aspectAliases = map[string]horoscope.AspectType{
+	"conjunction":     horoscope.Conjunction,
+	"conjuncts":       horoscope.Conjunction,
+	"sextiles":        horoscope.Sextile,
+	"squares":         horoscope.Square,
+	"trines":          horoscope.Trine,
+	"opposition":      horoscope.Opposition,
+	"opposes":         horoscope.Opposition,
+	"contra-parallel": horoscope.ContraParallel,
+}
+
+// Parse reads criteria such as
+//
+//	moon waxing, moon not void, moon in earth, mercury direct,
+//	venus trine natal sun orb 2
+//
+// Criteria are separated by commas, semicolons or "and". Each one names a
+// body followed by a predicate, either of which may be preceded by "not":
+//
+//	waxing | waning                  the Moon's phase
+//	void                             the Moon void of course
+//	direct | retrograde | stationary apparent motion
+//	in <sign | element | modality>   placement
+//	<aspect> [natal] <body> [orb N]  aspect to a transiting or natal body
+//
+// Aspect orbs default to horoscope.TightOrbs.
+func Parse(s string) ([]Criterion, error) {
+	var criteria []Criterion
+	for _, clause := range splitClauses(s) {
+		c, err := parseClause(clause)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", strings.Join(clause, " "), err)
+		}
+		criteria = append(criteria, c)
+	}
+	if len(criteria) == 0 {
+		return nil, fmt.Errorf("no criteria")
+	}
+	return criteria, nil
+}
+
+// splitClauses cuts criteria into lowercase words, one slice per criterion
+func splitClauses(s string) [][]string {
+	s = strings.NewReplacer(",", " , ", ";", " , ").Replace(strings.ToLower(s))
+	var clauses [][]string
+	var current []string
+	for _, word := range strings.Fields(s) {
+		if word == "," || word == "and" {
+			if len(current) > 0 {
+				clauses = append(clauses, current)
+			}
+			current = nil
+			continue
+		}
+		current = append(current, word)
+	}
+	if len(current) > 0 {
+		clauses = append(clauses, current)
+	}
+	return clauses
+}
+
+func parseClause(words []string) (Criterion, error) {
+	negate := false
+	if words[0] == "not" {
+		negate, words = true, words[1:]
+	}
+	body, words, ok := parseBody(words)
+	if !ok {
+		return nil, fmt.Errorf("expected a body")
+	}
+	if err := checkTransiting(body); err != nil {
+		return nil, err
+	}
+	if len(words) > 0 && words[0] == "is" {
+		words = words[1:]
+	}
+	if len(words) > 0 && words[0] == "not" {
+		negate, words = !negate, words[1:]
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("expected a predicate after %s", lower(body.String()))
+	}
+
+	c, err := parsePredicate(body, words)
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		return Not(c), nil
+	}
+	return c, nil
+}
+
+// parseBody reads the longest body name, up to three words long, at the
+// start of words
+func parseBody(words []string) (position.CelestialBody, []string, bool) {
+	for n := min(3, len(words)); n > 0; n-- {
+		if body, ok := position.BodyByName(strings.Join(words[:n], " ")); ok {
+			return body, words[n:], true
+		}
+	}
+	return 0, words, false
+}
+
+// checkTransiting rejects the points that need a chart location, which
+// only exist in natal charts
+func checkTransiting(body position.CelestialBody) error {
+	if !slices.Contains(position.AllBodies(), body) {
+		return fmt.Errorf("%s is not searched, only natal", lower(body.String()))
+	}
+	return nil
+}
+
+func parsePredicate(body position.CelestialBody, words []string) (Criterion, error) {
+	head, rest := words[0], words[1:]
+	simple := func(c Criterion) (Criterion, error) {
+		if len(rest) > 0 {
+			return nil, fmt.Errorf("unexpected %q", strings.Join(rest, " "))
+		}
+		return c, nil
+	}
+
+	switch head {
+	case "waxing", "waning", "void":
+		if body != position.Moon {
+			return nil, fmt.Errorf("%s applies to the moon only", head)
+		}
+		switch head {
+		case "waxing":
+			return simple(Waxing())
+		case "waning":
+			return simple(Waning())
+		}
+		return simple(VoidOfCourse())
+	case "direct":
+		return simple(Moving(body, Direct))
+	case "retrograde", "rx":
+		return simple(Moving(body, Retrograde))
+	case "stationary":
+		return simple(Moving(body, Stationary))
+	case "in":
+		if len(rest) != 1 {
+			return nil, fmt.Errorf("expected a sign, element or modality after in")
+		}
+		return parsePlacement(body, rest[0])
+	}
+
+	aspect, ok := parseAspect(head)
+	if !ok {
+		return nil, fmt.Errorf("unknown predicate %q", head)
+	}
+	natal := false
+	if len(rest) > 0 && rest[0] == "natal" {
+		natal, rest = true, rest[1:]
+	}
+	target, rest, ok := parseBody(rest)
+	if !ok {
+		return nil, fmt.Errorf("expected a body after %s", head)
+	}
+	if !natal {
+		if err := checkTransiting(target); err != nil {
+			return nil, err
+		}
+	}
+	orb := horoscope.TightOrbs[aspect]
+	if len(rest) > 0 {
+		if len(rest) != 2 || rest[0] != "orb" {
+			return nil, fmt.Errorf("unexpected %q", strings.Join(rest, " "))
+		}
+		v, err := strconv.ParseFloat(strings.TrimSuffix(rest[1], "°"), 64)
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("invalid orb %q", rest[1])
+		}
+		orb = v
+	}
+	return Aspect(body, aspect, target, natal, orb), nil
+}
+
+func parsePlacement(body position.CelestialBody, word string) (Criterion, error) {
+	for _, sign := range horoscope.AllSigns() {
+		if word == lower(sign.String()) {
+			return InSign(body, sign), nil
+		}
+	}
+	for e := horoscope.Fire; e <= horoscope.Water; e++ {
+		if word == lower(e.String()) {
+			return InElement(body, e), nil
+		}
+	}
+	for m := horoscope.Cardinal; m <= horoscope.Mutable; m++ {
+		if word == lower(m.String()) {
+			return InModality(body, m), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown sign, element or modality %q", word)
+}
+
+func parseAspect(word string) (horoscope.AspectType, bool) {
+	for a, w := range aspectWords {
+		if word == w {
+			return a, true
+		}
+	}
+	a, ok := aspectAliases[word]
+	return a, ok
+}
+
+func lower(s string) string {
+	return strings.ToLower(s)
+}
@@ -0,0 +1,153 @@
+package election
+
+import (
+	"math"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Period is a span of time
+type Period struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Contains reports whether t falls within the period, end excluded
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// voidStep is the sampling interval of the Moon when looking for its
+// aspects and sign changes; the Moon moves about 0.5° per hour
+const voidStep = time.Hour
+
+// voidMargin is how far beyond a range the Moon is followed, enough for
+// it to cross a whole sign
+const voidMargin = 72 * time.Hour
+
+// voidAspects are the Ptolemaic aspects ending a void-of-course Moon
+var voidAspects = []float64{0, 60, 90, 120, 180, 240, 270, 300}
+
+// voidBodies are the bodies the Moon must aspect not to be void of course
+var voidBodies = []position.CelestialBody{
+	position.Sun, position.Mercury, position.Venus, position.Mars,
+	position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto,
+}
+
+// VoidPeriods returns the void-of-course periods of the Moon overlapping
+// a range: from its last Ptolemaic aspect to the Sun or a planet (Sun to
+// Pluto) in a sign until it enters the next sign. Times are accurate to a
+// few minutes.
+func VoidPeriods(from, to time.Time) []Period {
+	return voidPeriods(from, to, position.CalculateAtDay)
+}
+
+// voidPeriods finds the void-of-course periods with the positions given
+// by positionAt, for a day number (see position.DayNumber)
+func voidPeriods(from, to time.Time, positionAt func(position.CelestialBody, float64) position.Position) []Period {
+	start := from.Add(-voidMargin)
+	end := to.Add(voidMargin)
+
+	var periods []Period
+	var lastAspect time.Time
+	var signStart time.Time
+
+	prev := moonSample(start, positionAt)
+	for t := start.Add(voidStep); !t.After(end); t = t.Add(voidStep) {
+		cur := moonSample(t, positionAt)
+
+		var ingress time.Time
+		changed := sign(cur.moon) != sign(prev.moon)
+		if changed {
+			ingress = interpolate(prev.t, cur.t, signCrossing(prev.moon, cur.moon))
+		}
+
+		// Aspects perfected after an ingress in the same step belong to
+		// the new sign
+		var nextAspect time.Time
+		for i := range voidBodies {
+			at, ok := perfection(prev, cur, i)
+			switch {
+			case !ok:
+			case changed && !at.Before(ingress):
+				if at.After(nextAspect) {
+					nextAspect = at
+				}
+			case at.After(lastAspect):
+				lastAspect = at
+			}
+		}
+
+		if changed {
+			// The first sign is only partly followed, its void is unknown
+			if !signStart.IsZero() {
+				voidStart := signStart
+				if lastAspect.After(signStart) {
+					voidStart = lastAspect
+				}
+				if ingress.After(from) && voidStart.Before(to) {
+					periods = append(periods, Period{Start: voidStart, End: ingress})
+				}
+			}
+			signStart = ingress
+			lastAspect = nextAspect
+		}
+		prev = cur
+	}
+	return periods
+}
+
+// sample holds the longitudes of the Moon and of the void bodies
+type sample struct {
+	t      time.Time
+	moon   float64
+	bodies []float64
+}
+
+func moonSample(t time.Time, positionAt func(position.CelestialBody, float64) position.Position) sample {
+	d := position.DayNumber(t)
+	s := sample{t: t, moon: positionAt(position.Moon, d).EclipticLongitude}
+	s.bodies = make([]float64, len(voidBodies))
+	for i, body := range voidBodies {
+		s.bodies[i] = positionAt(body, d).EclipticLongitude
+	}
+	return s
+}
+
+// perfection finds the time at which the Moon perfects an aspect to the
+// ith void body between two samples. The Moon is always faster than the
+// other bodies, so their elongation only grows.
+func perfection(a, b sample, i int) (time.Time, bool) {
+	e1 := position.NormalizeAngle(a.moon - a.bodies[i])
+	e2 := position.NormalizeAngle(b.moon - b.bodies[i])
+	for _, angle := range voidAspects {
+		d1 := position.NormalizeAngle(e1-angle+180) - 180
+		d2 := position.NormalizeAngle(e2-angle+180) - 180
+		if d1 < 0 && d2 >= 0 && d2-d1 < 30 {
+			return interpolate(a.t, b.t, -d1/(d2-d1)), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func sign(longitude float64) int {
+	return int(longitude / 30)
+}
+
+// signCrossing returns the fraction of the way from l1 to l2 at which the
+// longitude crosses the start of a sign
+func signCrossing(l1, l2 float64) float64 {
+	if l2 < l1 {
+		l2 += 360
+	}
+	boundary := math.Floor(l2/30) * 30
+	if l2 == l1 {
+		return 0
+	}
+	return (boundary - l1) / (l2 - l1)
+}
+
+func interpolate(a, b time.Time, f float64) time.Time {
+	return a.Add(time.Duration(f * float64(b.Sub(a))))
+}
@@ -0,0 +1,225 @@
+package ephemeris
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// formatVersion is bumped whenever the cache layout or fitting changes
+const formatVersion = 1
+
+// granule sets the interval length (days) and number of coefficients used
+// for a body. Fast or wobbly bodies get shorter intervals.
+type granule struct {
+	span   float64
+	degree int
+}
+
+var (
+	fastGranule  = granule{span: 4, degree: 14}  // Moon, true node, osculating Lilith
+	innerGranule = granule{span: 16, degree: 14} // Sun to Mars, mean points
+	minorGranule = granule{span: 32, degree: 14} // Asteroids and registered bodies
+	outerGranule = granule{span: 64, degree: 14} // Jupiter and beyond
+)
+
+func granuleFor(body position.CelestialBody, opts position.Options) granule {
+	switch body {
+	case position.Moon:
+		return fastGranule
+	case position.NorthNode, position.SouthNode:
+		if opts.Nodes == position.TrueNode {
+			return fastGranule
+		}
+		return innerGranule
+	case position.Lilith:
+		if opts.Lilith == position.OsculatingLilith {
+			return fastGranule
+		}
+		return innerGranule
+	case position.Sun, position.Mercury, position.Venus, position.Mars:
+		return innerGranule
+	case position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto:
+		return outerGranule
+	}
+	return minorGranule
+}
+
+// Segment holds the series of one body over one interval
+type Segment struct {
+	Longitude []float64 // unwrapped ecliptic longitude (degrees)
+	Latitude  []float64 // ecliptic latitude (degrees)
+	Distance  []float64 // AU
+}
+
+// Table holds consecutive segments of one body starting at day Start
+type Table struct {
+	Start    float64
+	Span     float64
+	Segments []Segment
+}
+
+// Cache holds Chebyshev tables for every body over a range of day numbers
+// (see position.DayNumber), built for one set of calculation options
+type Cache struct {
+	Version int
+	Key     uint64
+	From    float64
+	To      float64
+	Options position.Options
+	Tables  map[position.CelestialBody]Table
+}
+
+// Build fits every body of position.AllBodies between two times
+func Build(from, to time.Time, opts position.Options) *Cache {
+	c := &Cache{
+		Version: formatVersion,
+		From:    position.DayNumber(from),
+		To:      position.DayNumber(to),
+		Options: opts,
+		Tables:  make(map[position.CelestialBody]Table),
+	}
+	c.Key = key(c.From, c.To, opts)
+
+	for _, body := range position.AllBodies() {
+		c.Tables[body] = buildTable(body, c.From, c.To, opts)
+	}
+	return c
+}
+
+// buildTable fits the intervals of a body covering [from, to]
+func buildTable(body position.CelestialBody, from, to float64, opts position.Options) Table {
+	g := granuleFor(body, opts)
+	count := int(math.Ceil((to - from) / g.span))
+	if count < 1 {
+		count = 1
+	}
+
+	table := Table{Start: from, Span: g.span, Segments: make([]Segment, count)}
+	x := nodes(g.degree)
+	for i := range table.Segments {
+		start := from + float64(i)*g.span
+		lon := make([]float64, len(x))
+		lat := make([]float64, len(x))
+		dist := make([]float64, len(x))
+		for k, xk := range x {
+			p := position.CalculateAtDayWithOptions(body, start+(xk+1)*g.span/2, opts)
+			lon[k], lat[k], dist[k] = p.EclipticLongitude, p.EclipticLatitude, p.Distance
+		}
+		unwrap(lon)
+		table.Segments[i] = Segment{Longitude: fit(lon), Latitude: fit(lat), Distance: fit(dist)}
+	}
+	return table
+}
+
+// Covers reports whether the cache holds positions for a time
+func (c *Cache) Covers(t time.Time) bool {
+	d := position.DayNumber(t)
+	return d >= c.From && d <= c.To
+}
+
+// PositionAtDay returns a body's position for a day number, interpolated
+// when the cache covers it and calculated directly otherwise
+func (c *Cache) PositionAtDay(body position.CelestialBody, d float64) position.Position {
+	table, ok := c.Tables[body]
+	if !ok || d < c.From || d > c.To {
+		return position.CalculateAtDayWithOptions(body, d, c.Options)
+	}
+
+	i := int((d - table.Start) / table.Span)
+	if i >= len(table.Segments) {
+		i = len(table.Segments) - 1
+	}
+	seg := table.Segments[i]
+	x := 2*(d-table.Start-float64(i)*table.Span)/table.Span - 1
+
+	return position.Position{
+		Body:              body,
+		EclipticLongitude: position.NormalizeAngle(eval(seg.Longitude, x)),
+		EclipticLatitude:  eval(seg.Latitude, x),
+		Distance:          eval(seg.Distance, x),
+	}.WithEquatorial()
+}
+
+// CalculateAll is the cached equivalent of position.CalculateAllWithOptions
+func (c *Cache) CalculateAll(t time.Time) []position.Position {
+	d := position.DayNumber(t)
+	bodies := position.AllBodies()
+	positions := make([]position.Position, len(bodies))
+	for i, body := range bodies {
+		positions[i] = position.WithMotion(body, d, func(d float64) position.Position {
+			return c.PositionAtDay(body, d)
+		})
+	}
+	return positions
+}
+
+// Open loads the cache for a range and options from the user cache
+// directory, building and saving it when missing or out of date
+func Open(from, to time.Time, opts position.Options) (*Cache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return Build(from, to, opts), err
+	}
+	k := key(position.DayNumber(from), position.DayNumber(to), opts)
+	path := filepath.Join(dir, "astral-tui", fmt.Sprintf("ephemeris-%016x.gob", k))
+
+	if c, err := Load(path); err == nil && c.Version == formatVersion && c.Key == k {
+		return c, nil
+	}
+
+	c := Build(from, to, opts)
+	return c, c.Save(path)
+}
+
+// Load reads a cache written by Save
+func Load(path string) (*Cache, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var c Cache
+	if err := gob.NewDecoder(f).Decode(&c); err != nil {
+		return nil, fmt.Errorf("invalid ephemeris cache %s: %w", path, err)
+	}
+	if c.Tables == nil {
+		return nil, errors.New("empty ephemeris cache")
+	}
+	return &c, nil
+}
+
+// Save writes the cache, creating its directory if needed
+func (c *Cache) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(c); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// key identifies a cache by its range, options and the orbital elements
+// of every body, so registering bodies or changing elements invalidates it
+func key(from, to float64, opts position.Options) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d|%.6f|%.6f|%d|%d", formatVersion, from, to, opts.Nodes, opts.Lilith)
+	for _, body := range position.AllBodies() {
+		fmt.Fprintf(h, "|%d:%s:%v", body, body, position.PlanetElements[body])
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,175 @@
+package ephemeris
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+var (
+	testFrom = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	testTo   = time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// Error bounds in arcseconds. Osculating Lilith follows the wobbles of the
+// instantaneous lunar orbit and is the hardest series to fit.
+const (
+	maxError       = 0.01
+	maxLilithError = 0.1
+)
+
+var testOptions = map[string]position.Options{
+	"mean": position.DefaultOptions,
+	"true": {Nodes: position.TrueNode, Lilith: position.OsculatingLilith},
+}
+
+// arcsec returns the difference between two angles in arcseconds
+func arcsec(a, b float64) float64 {
+	d := math.Mod(a-b+540, 360) - 180
+	return math.Abs(d) * 3600
+}
+
+// sampleDays returns day numbers every step across the cache, plus the
+// edges of every segment of a table and the ends of the range
+func sampleDays(c *Cache, table Table, step float64) []float64 {
+	var days []float64
+	for d := c.From; d <= c.To; d += step {
+		days = append(days, d)
+	}
+	for i := range table.Segments {
+		edge := table.Start + float64(i)*table.Span
+		days = append(days, edge, edge+1e-6, edge-1e-6)
+	}
+	return append(days, c.To)
+}
+
+func TestPositionAtDayError(t *testing.T) {
+	for name, opts := range testOptions {
+		t.Run(name, func(t *testing.T) {
+			c := Build(testFrom, testTo, opts)
+			for _, body := range position.AllBodies() {
+				bound := maxError
+				if body == position.Lilith && opts.Lilith == position.OsculatingLilith {
+					bound = maxLilithError
+				}
+
+				var worstLon, worstLat, worstDist float64
+				for _, d := range sampleDays(c, c.Tables[body], 0.37) {
+					if d < c.From || d > c.To {
+						continue
+					}
+					got := c.PositionAtDay(body, d)
+					want := position.CalculateAtDayWithOptions(body, d, opts)
+					worstLon = math.Max(worstLon, arcsec(got.EclipticLongitude, want.EclipticLongitude))
+					worstLat = math.Max(worstLat, arcsec(got.EclipticLatitude, want.EclipticLatitude))
+					worstDist = math.Max(worstDist, math.Abs(got.Distance-want.Distance))
+				}
+				if worstLon > bound || worstLat > bound {
+					t.Errorf("%s: longitude error %.4f\", latitude error %.4f\", want below %g\"",
+						body, worstLon, worstLat, bound)
+				}
+				if worstDist > 1e-8 {
+					t.Errorf("%s: distance error %g AU", body, worstDist)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateAllError(t *testing.T) {
+	for name, opts := range testOptions {
+		t.Run(name, func(t *testing.T) {
+			c := Build(testFrom, testTo, opts)
+			for at := testFrom; !at.After(testTo); at = at.Add(97*time.Hour + 13*time.Minute) {
+				got := c.CalculateAll(at)
+				want := position.CalculateAllWithOptions(at, opts)
+				for i := range want {
+					bound := maxError
+					if want[i].Body == position.Lilith && opts.Lilith == position.OsculatingLilith {
+						bound = maxLilithError
+					}
+					if e := arcsec(got[i].EclipticLongitude, want[i].EclipticLongitude); e > bound {
+						t.Fatalf("%s at %s: longitude error %.4f\"", want[i].Body, at, e)
+					}
+					// Speeds come from differences of interpolated positions
+					if e := math.Abs(got[i].Speed-want[i].Speed) * 3600; e > 10*bound {
+						t.Fatalf("%s at %s: speed error %.4f\"/day", want[i].Body, at, e)
+					}
+					if got[i].Retrograde != want[i].Retrograde && !want[i].Stationary {
+						t.Fatalf("%s at %s: retrograde %v, want %v", want[i].Body, at, got[i].Retrograde, want[i].Retrograde)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPositionAtDayOutsideRange(t *testing.T) {
+	c := Build(testFrom, testTo, position.DefaultOptions)
+	for _, d := range []float64{c.From - 1, c.To + 1} {
+		got := c.PositionAtDay(position.Moon, d)
+		want := position.CalculateAtDayWithOptions(position.Moon, d, position.DefaultOptions)
+		if got != want {
+			t.Errorf("day %g: got %+v, want the direct position %+v", d, got, want)
+		}
+	}
+}
+
+func TestOpenSavesAndReloads(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+	from, to := testFrom, testFrom.AddDate(0, 3, 0)
+
+	built, err := Open(from, to, position.DefaultOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(cacheDir, "astral-tui", "ephemeris-*.gob"))
+	if len(files) != 1 {
+		t.Fatalf("found %d cache files, want 1", len(files))
+	}
+	saved, err := os.Stat(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Open(from, to, position.DefaultOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded, built) {
+		t.Error("reloaded cache differs from the built one")
+	}
+	if again, _ := os.Stat(files[0]); !again.ModTime().Equal(saved.ModTime()) {
+		t.Error("cache file rewritten instead of reloaded")
+	}
+}
+
+func BenchmarkCalculateAll(b *testing.B) {
+	c := Build(testFrom, testTo, position.DefaultOptions)
+	span := testTo.Sub(testFrom)
+	at := func(i int) time.Time {
+		return testFrom.Add(time.Duration(i%1000) * span / 1000)
+	}
+
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			c.CalculateAll(at(i))
+		}
+	})
+	b.Run("direct", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			position.CalculateAllWithOptions(at(i), position.DefaultOptions)
+		}
+	})
+}
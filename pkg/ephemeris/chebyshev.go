@@ -0,0 +1,54 @@
+// Package ephemeris caches body positions as Chebyshev polynomials so that
+// range scans evaluate a few polynomials instead of solving Kepler's equation.
+package ephemeris
+
+import "math"
+
+// nodes returns the n Chebyshev nodes on [-1, 1] in increasing order
+func nodes(n int) []float64 {
+	x := make([]float64, n)
+	for k := range x {
+		x[k] = -math.Cos(math.Pi * (float64(k) + 0.5) / float64(n))
+	}
+	return x
+}
+
+// fit returns the coefficients of the Chebyshev series interpolating
+// samples taken at nodes(len(samples))
+func fit(samples []float64) []float64 {
+	n := len(samples)
+	coeffs := make([]float64, n)
+	for j := range coeffs {
+		sum := 0.0
+		for k, f := range samples {
+			// nodes are in increasing order, so node k is the cosine of angle n-1-k
+			theta := math.Pi * (float64(n-1-k) + 0.5) / float64(n)
+			sum += f * math.Cos(float64(j)*theta)
+		}
+		coeffs[j] = 2 * sum / float64(n)
+	}
+	coeffs[0] /= 2
+	return coeffs
+}
+
+// eval evaluates a Chebyshev series at x in [-1, 1] with Clenshaw's recurrence
+func eval(coeffs []float64, x float64) float64 {
+	var b1, b2 float64
+	for j := len(coeffs) - 1; j >= 1; j-- {
+		b1, b2 = 2*x*b1-b2+coeffs[j], b1
+	}
+	return x*b1 - b2 + coeffs[0]
+}
+
+// unwrap removes the 360° jumps between successive longitudes so that
+// a polynomial can follow them
+func unwrap(lons []float64) {
+	for i := 1; i < len(lons); i++ {
+		for lons[i]-lons[i-1] > 180 {
+			lons[i] -= 360
+		}
+		for lons[i]-lons[i-1] < -180 {
+			lons[i] += 360
+		}
+	}
+}
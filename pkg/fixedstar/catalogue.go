@@ -0,0 +1,121 @@
+// Package fixedstar provides a catalogue of astrologically significant fixed
+// stars and their positions at any epoch.
+package fixedstar
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed catalogue.csv
+var catalogueData string
+
+// Star is a catalogue entry with J2000 coordinates
+type Star struct {
+	Name      string
+	RA        float64 // Right ascension at J2000 (degrees)
+	Dec       float64 // Declination at J2000 (degrees)
+	PMRA      float64 // Proper motion in right ascension, times cos(Dec) (mas/year)
+	PMDec     float64 // Proper motion in declination (mas/year)
+	Magnitude float64 // Apparent visual magnitude
+}
+
+var catalogue = mustParseCatalogue(catalogueData)
+
+// Catalogue returns all stars, ordered by right ascension
+func Catalogue() []Star {
+	stars := make([]Star, len(catalogue))
+	copy(stars, catalogue)
+	return stars
+}
+
+// Find returns the star with the given name (case insensitive)
+func Find(name string) (Star, bool) {
+	for _, s := range catalogue {
+		if strings.EqualFold(s.Name, name) {
+			return s, true
+		}
+	}
+	return Star{}, false
+}
+
+// mustParseCatalogue parses the embedded catalogue.
+// The data ships with the binary, so a malformed line is a programming error.
+func mustParseCatalogue(data string) []Star {
+	var stars []Star
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		star, err := parseStar(line)
+		if err != nil {
+			panic(fmt.Sprintf("fixedstar: catalogue line %d: %v", i+1, err))
+		}
+		stars = append(stars, star)
+	}
+	return stars
+}
+
+func parseStar(line string) (Star, error) {
+	fields := strings.Split(line, ",")
+	if len(fields) != 6 {
+		return Star{}, fmt.Errorf("expected 6 fields, got %d", len(fields))
+	}
+
+	ra, err := parseSexagesimal(fields[1])
+	if err != nil {
+		return Star{}, fmt.Errorf("right ascension: %w", err)
+	}
+	dec, err := parseSexagesimal(fields[2])
+	if err != nil {
+		return Star{}, fmt.Errorf("declination: %w", err)
+	}
+
+	var nums [3]float64
+	for i, f := range fields[3:] {
+		nums[i], err = strconv.ParseFloat(strings.TrimSpace(f), 64)
+		if err != nil {
+			return Star{}, err
+		}
+	}
+
+	return Star{
+		Name:      strings.TrimSpace(fields[0]),
+		RA:        ra * 15, // hours to degrees
+		Dec:       dec,
+		PMRA:      nums[0],
+		PMDec:     nums[1],
+		Magnitude: nums[2],
+	}, nil
+}
+
+// parseSexagesimal parses "[+-]dd:mm:ss.s" into decimal units
+func parseSexagesimal(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	sign := 1.0
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = s[1:]
+	}
+	s = strings.TrimPrefix(s, "+")
+
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid sexagesimal value %q", s)
+	}
+
+	value := 0.0
+	scale := 1.0
+	for _, p := range parts {
+		n, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return 0, err
+		}
+		value += n / scale
+		scale *= 60
+	}
+	return sign * value, nil
+}
@@ -0,0 +1,56 @@
+package fixedstar
+
+import (
+	"math"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// masPerDegree converts milliarcseconds to degrees
+const masPerDegree = 3600 * 1000
+
+// EquatorialAt returns the right ascension and declination of the star,
+// referred to the mean equinox of date, in degrees.
+// Proper motion is applied first, then IAU 1976 precession (Meeus, chapter 21).
+func (s Star) EquatorialAt(t time.Time) (float64, float64) {
+	d := position.JulianDay(t) - position.J2000
+	years := d / 365.25
+
+	dec0 := s.Dec + s.PMDec*years/masPerDegree
+	ra0 := s.RA
+	if c := math.Cos(position.DegreesToRadians(s.Dec)); c > 1e-9 {
+		ra0 += s.PMRA * years / masPerDegree / c
+	}
+
+	return precess(ra0, dec0, d/36525)
+}
+
+// EclipticAt returns the ecliptic longitude and latitude of the star
+// for the equinox of date, in degrees
+func (s Star) EclipticAt(t time.Time) (float64, float64) {
+	ra, dec := s.EquatorialAt(t)
+	return position.EquatorialToEcliptic(ra, dec)
+}
+
+// precess moves J2000 equatorial coordinates to the mean equinox
+// T Julian centuries later
+func precess(ra, dec, t float64) (float64, float64) {
+	arcsec := func(a, b, c float64) float64 {
+		return position.DegreesToRadians((a*t + b*t*t + c*t*t*t) / 3600)
+	}
+	zeta := arcsec(2306.2181, 0.30188, 0.017998)
+	z := arcsec(2306.2181, 1.09468, 0.018203)
+	theta := arcsec(2004.3109, -0.42665, -0.041833)
+
+	alpha := position.DegreesToRadians(ra)
+	delta := position.DegreesToRadians(dec)
+
+	a := math.Cos(delta) * math.Sin(alpha+zeta)
+	b := math.Cos(theta)*math.Cos(delta)*math.Cos(alpha+zeta) - math.Sin(theta)*math.Sin(delta)
+	c := math.Sin(theta)*math.Cos(delta)*math.Cos(alpha+zeta) + math.Cos(theta)*math.Sin(delta)
+
+	raDate := position.RadiansToDegrees(math.Atan2(a, b) + z)
+	decDate := position.RadiansToDegrees(math.Asin(c))
+	return position.NormalizeAngle(raDate), decDate
+}
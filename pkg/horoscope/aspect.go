@@ -11,11 +11,14 @@ type AspectType int
 
 // Aspect types with their exact angles.
 const (
-	Conjunction AspectType = iota // 0 degrees
-	Sextile                       // 60 degrees
-	Square                        // 90 degrees
-	Trine                         // 120 degrees
-	Opposition                    // 180 degrees
+	Conjunction    AspectType = iota // 0 degrees
+	Sextile                          // 60 degrees
+	Square                           // 90 degrees
+	Trine                            // 120 degrees
+	Opposition                       // 180 degrees
+	Quincunx                         // 150 degrees
+	Parallel                         // Same declination
+	ContraParallel                   // Opposite declination
 )
 
 // String returns the name of the aspect
@@ -35,7 +38,13 @@ func (a AspectType) Angle() float64 {
 
 // IsHarmonic returns true if this is a harmonious aspect
 func (a AspectType) IsHarmonic() bool {
-	return a == Conjunction || a == Sextile || a == Trine
+	return a == Conjunction || a == Sextile || a == Trine || a == Parallel
+}
+
+// IsDeclination returns true if the aspect is measured in declination
+// rather than in ecliptic longitude
+func (a AspectType) IsDeclination() bool {
+	return a == Parallel || a == ContraParallel
 }
 
 var aspectNames = map[AspectType]string{
@@ -44,6 +53,10 @@ var aspectNames = map[AspectType]string{
 	Square:      "Square",
 	Trine:       "Trine",
 	Opposition:  "Opposition",
+	Quincunx:    "Quincunx",
+
+	Parallel:       "Parallel",
+	ContraParallel: "Contra-parallel",
 }
 
 var aspectSymbols = map[AspectType]string{
@@ -52,6 +65,10 @@ var aspectSymbols = map[AspectType]string{
 	Square:      "□",
 	Trine:       "△",
 	Opposition:  "☍",
+	Quincunx:    "⚻",
+
+	Parallel:       "∥",
+	ContraParallel: "⋕",
 }
 
 var aspectAngles = map[AspectType]float64{
@@ -60,6 +77,10 @@ var aspectAngles = map[AspectType]float64{
 	Square:      90,
 	Trine:       120,
 	Opposition:  180,
+	Quincunx:    150,
+
+	Parallel:       0,
+	ContraParallel: 0,
 }
 
 // Orbs contains the allowed orb (deviation) for each aspect type
@@ -72,6 +93,10 @@ var DefaultOrbs = Orbs{
 	Square:      7.0,
 	Trine:       8.0,
 	Opposition:  8.0,
+	Quincunx:    3.0,
+
+	Parallel:       1.0,
+	ContraParallel: 1.0,
 }
 
 // TightOrbs provides stricter orb values
@@ -81,6 +106,10 @@ var TightOrbs = Orbs{
 	Square:      5.0,
 	Trine:       5.0,
 	Opposition:  5.0,
+	Quincunx:    2.0,
+
+	Parallel:       0.5,
+	ContraParallel: 0.5,
 }
 
 // Aspect represents an aspect between two celestial bodies
@@ -88,12 +117,23 @@ type Aspect struct {
 	Body1    position.CelestialBody
 	Body2    position.CelestialBody
 	Type     AspectType
-	Angle    float64 // Actual angle between bodies
+	Angle    float64 // Actual angle between bodies (declination difference for parallels)
 	Orb      float64 // Deviation from exact aspect
 	Applying bool    // True if aspect is applying (getting tighter)
+	OrbSpeed float64 // Change of orb in degrees/day, negative while applying
 }
 
-// CalculateAspects finds all aspects between a set of positions
+// DaysToExact estimates the days until an applying aspect perfects,
+// from the current speeds of both bodies
+func (a Aspect) DaysToExact() (float64, bool) {
+	if !a.Applying || a.OrbSpeed == 0 {
+		return 0, false
+	}
+	return a.Orb / -a.OrbSpeed, true
+}
+
+// CalculateAspects finds all aspects between a set of positions.
+// A pair may form both a longitude aspect and a declination aspect.
 func CalculateAspects(positions []position.Position, orbs Orbs) []Aspect {
 	var aspects []Aspect
 
@@ -102,6 +142,9 @@ func CalculateAspects(positions []position.Position, orbs Orbs) []Aspect {
 			if aspect := findAspect(positions[i], positions[j], orbs); aspect != nil {
 				aspects = append(aspects, *aspect)
 			}
+			if aspect := findDeclinationAspect(positions[i], positions[j], orbs); aspect != nil {
+				aspects = append(aspects, *aspect)
+			}
 		}
 	}
 
@@ -117,9 +160,10 @@ func findAspect(p1, p2 position.Position, orbs Orbs) *Aspect {
 	}
 
 	// Check each aspect type
-	aspectTypes := []AspectType{Conjunction, Sextile, Square, Trine, Opposition}
-
-	for _, aspectType := range aspectTypes {
+	for _, aspectType := range AllAspectTypes() {
+		if aspectType.IsDeclination() {
+			continue
+		}
 		exactAngle := aspectType.Angle()
 		orb := math.Abs(angle - exactAngle)
 
@@ -129,12 +173,15 @@ func findAspect(p1, p2 position.Position, orbs Orbs) *Aspect {
 		}
 
 		if orb <= maxOrb {
+			orbSpeed := orbSpeed(p1, p2, exactAngle)
 			return &Aspect{
-				Body1: p1.Body,
-				Body2: p2.Body,
-				Type:  aspectType,
-				Angle: angle,
-				Orb:   orb,
+				Body1:    p1.Body,
+				Body2:    p2.Body,
+				Type:     aspectType,
+				Angle:    angle,
+				Orb:      orb,
+				Applying: orbSpeed < 0,
+				OrbSpeed: orbSpeed,
 			}
 		}
 	}
@@ -142,6 +189,54 @@ func findAspect(p1, p2 position.Position, orbs Orbs) *Aspect {
 	return nil
 }
 
+// orbSpeed returns how fast the orb to an exact angle changes (degrees/day)
+// given the longitudinal speeds of both bodies
+func orbSpeed(p1, p2 position.Position, exactAngle float64) float64 {
+	diff := position.NormalizeMotion(p1.EclipticLongitude - p2.EclipticLongitude)
+	separation := math.Abs(diff)
+
+	// Rate at which the shorter arc between the bodies grows
+	sepSpeed := p1.Speed - p2.Speed
+	if diff < 0 {
+		sepSpeed = -sepSpeed
+	}
+
+	switch {
+	case separation > exactAngle:
+		return sepSpeed
+	case separation < exactAngle:
+		return -sepSpeed
+	}
+	return 0
+}
+
+// findDeclinationAspect checks if two positions are parallel (same declination,
+// same hemisphere) or contra-parallel (same declination, opposite hemispheres)
+func findDeclinationAspect(p1, p2 position.Position, orbs Orbs) *Aspect {
+	aspectType := Parallel
+	diff := math.Abs(p1.Declination - p2.Declination)
+	if (p1.Declination < 0) != (p2.Declination < 0) {
+		aspectType = ContraParallel
+		diff = math.Abs(p1.Declination + p2.Declination)
+	}
+
+	maxOrb := orbs[aspectType]
+	if maxOrb == 0 {
+		maxOrb = DefaultOrbs[aspectType]
+	}
+	if diff > maxOrb {
+		return nil
+	}
+
+	return &Aspect{
+		Body1: p1.Body,
+		Body2: p2.Body,
+		Type:  aspectType,
+		Angle: math.Abs(p1.Declination - p2.Declination),
+		Orb:   diff,
+	}
+}
+
 // AspectBetween calculates the aspect (if any) between two specific bodies
 func AspectBetween(p1, p2 position.Position, orbs Orbs) *Aspect {
 	return findAspect(p1, p2, orbs)
@@ -149,5 +244,5 @@ func AspectBetween(p1, p2 position.Position, orbs Orbs) *Aspect {
 
 // AllAspectTypes returns all aspect types
 func AllAspectTypes() []AspectType {
-	return []AspectType{Conjunction, Sextile, Square, Trine, Opposition}
+	return []AspectType{Conjunction, Sextile, Square, Trine, Opposition, Quincunx, Parallel, ContraParallel}
 }
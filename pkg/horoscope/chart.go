@@ -14,14 +14,50 @@ type Chart struct {
 	Longitude float64
 	Location  string
 
+	// Heliocentric charts have the Earth instead of the Sun and no houses
+	Heliocentric bool
+	// Harmonic is the harmonic number of harmonic charts, 0 for the radix
+	Harmonic int
+	// Relocation is set on relocated charts, cast for another place than
+	// the birth place
+	Relocation *Relocation
+
 	Positions []position.Position
 	Houses    HouseCusps
 	Aspects   []Aspect
+
+	StarContacts []StarContact
+	Sky          []position.SkyPosition
+}
+
+// Relocation records the birth place of a relocated chart and its houses
+type Relocation struct {
+	Latitude  float64
+	Longitude float64
+	Location  string
+	Houses    HouseCusps // Houses at the birth place
 }
 
 // HouseCusps interface for house calculation results
 type HouseCusps interface {
 	GetHouse(longitude float64) int
+	GetAscendant() float64
+	GetMC() float64
+}
+
+// HeliocentricChart builds the heliocentric counterpart of the chart for the
+// same date and place, with aspects between the heliocentric positions
+func (c *Chart) HeliocentricChart() *Chart {
+	positions := position.CalculateHeliocentric(c.DateTime)
+	return &Chart{
+		DateTime:     c.DateTime,
+		Latitude:     c.Latitude,
+		Longitude:    c.Longitude,
+		Location:     c.Location,
+		Heliocentric: true,
+		Positions:    positions,
+		Aspects:      CalculateAspects(positions, DefaultOrbs),
+	}
 }
 
 // BodyInHouse returns the house number for a given body
@@ -37,6 +73,19 @@ func (c *Chart) BodyInHouse(body position.CelestialBody) int {
 	return 0
 }
 
+// NatalHouse returns the house of a body at the birth place, which differs
+// from BodyInHouse on relocated charts
+func (c *Chart) NatalHouse(body position.CelestialBody) int {
+	if c.Relocation == nil || c.Relocation.Houses == nil {
+		return c.BodyInHouse(body)
+	}
+	pos := c.GetPosition(body)
+	if pos == nil {
+		return 0
+	}
+	return c.Relocation.Houses.GetHouse(pos.EclipticLongitude)
+}
+
 // GetPosition returns the position of a specific body
 func (c *Chart) GetPosition(body position.CelestialBody) *position.Position {
 	for _, pos := range c.Positions {
@@ -0,0 +1,350 @@
+package horoscope
+
+import (
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// DignityType represents a kind of essential dignity or debility
+type DignityType int
+
+// Essential dignities and debilities, in traditional order of strength.
+const (
+	Domicile DignityType = iota
+	Exaltation
+	Triplicity
+	Term
+	Face
+	Detriment
+	Fall
+	Peregrine
+)
+
+// String returns the name of the dignity
+func (d DignityType) String() string {
+	return dignityNames[d]
+}
+
+// Score returns the traditional (Lilly) point value of the dignity
+func (d DignityType) Score() int {
+	return dignityScores[d]
+}
+
+var dignityNames = map[DignityType]string{
+	Domicile:   "Domicile",
+	Exaltation: "Exaltation",
+	Triplicity: "Triplicity",
+	Term:       "Term",
+	Face:       "Face",
+	Detriment:  "Detriment",
+	Fall:       "Fall",
+	Peregrine:  "Peregrine",
+}
+
+var dignityScores = map[DignityType]int{
+	Domicile:   5,
+	Exaltation: 4,
+	Triplicity: 3,
+	Term:       2,
+	Face:       1,
+	Detriment:  -5,
+	Fall:       -4,
+	Peregrine:  -5,
+}
+
+// Traditional domicile rulers
+var signRulers = map[ZodiacSign]position.CelestialBody{
+	Aries:       position.Mars,
+	Taurus:      position.Venus,
+	Gemini:      position.Mercury,
+	Cancer:      position.Moon,
+	Leo:         position.Sun,
+	Virgo:       position.Mercury,
+	Libra:       position.Venus,
+	Scorpio:     position.Mars,
+	Sagittarius: position.Jupiter,
+	Capricorn:   position.Saturn,
+	Aquarius:    position.Saturn,
+	Pisces:      position.Jupiter,
+}
+
+// Signs of exaltation for the seven traditional planets
+var exaltations = map[ZodiacSign]position.CelestialBody{
+	Aries:     position.Sun,
+	Taurus:    position.Moon,
+	Virgo:     position.Mercury,
+	Pisces:    position.Venus,
+	Capricorn: position.Mars,
+	Cancer:    position.Jupiter,
+	Libra:     position.Saturn,
+}
+
+// Dorothean triplicity rulers: day, night and participating
+var triplicityRulers = map[Element][3]position.CelestialBody{
+	Fire:  {position.Sun, position.Jupiter, position.Saturn},
+	Earth: {position.Venus, position.Moon, position.Mars},
+	Air:   {position.Saturn, position.Mercury, position.Jupiter},
+	Water: {position.Venus, position.Mars, position.Moon},
+}
+
+// termBound is the end degree (exclusive) of a term and its ruler
+type termBound struct {
+	end   float64
+	ruler position.CelestialBody
+}
+
+// Egyptian terms (bounds) per sign
+var egyptianTerms = map[ZodiacSign][5]termBound{
+	Aries:       {{6, position.Jupiter}, {12, position.Venus}, {20, position.Mercury}, {25, position.Mars}, {30, position.Saturn}},
+	Taurus:      {{8, position.Venus}, {14, position.Mercury}, {22, position.Jupiter}, {27, position.Saturn}, {30, position.Mars}},
+	Gemini:      {{6, position.Mercury}, {12, position.Jupiter}, {17, position.Venus}, {24, position.Mars}, {30, position.Saturn}},
+	Cancer:      {{7, position.Mars}, {13, position.Venus}, {19, position.Mercury}, {26, position.Jupiter}, {30, position.Saturn}},
+	Leo:         {{6, position.Jupiter}, {11, position.Venus}, {18, position.Saturn}, {24, position.Mercury}, {30, position.Mars}},
+	Virgo:       {{7, position.Mercury}, {17, position.Venus}, {21, position.Jupiter}, {28, position.Mars}, {30, position.Saturn}},
+	Libra:       {{6, position.Saturn}, {14, position.Mercury}, {21, position.Jupiter}, {28, position.Venus}, {30, position.Mars}},
+	Scorpio:     {{7, position.Mars}, {11, position.Venus}, {19, position.Mercury}, {24, position.Jupiter}, {30, position.Saturn}},
+	Sagittarius: {{12, position.Jupiter}, {17, position.Venus}, {21, position.Mercury}, {26, position.Saturn}, {30, position.Mars}},
+	Capricorn:   {{7, position.Mercury}, {14, position.Jupiter}, {22, position.Venus}, {26, position.Saturn}, {30, position.Mars}},
+	Aquarius:    {{7, position.Mercury}, {13, position.Venus}, {20, position.Jupiter}, {25, position.Mars}, {30, position.Saturn}},
+	Pisces:      {{12, position.Venus}, {16, position.Jupiter}, {19, position.Mercury}, {28, position.Mars}, {30, position.Saturn}},
+}
+
+// Chaldean order used to assign faces (decans), starting from Mars at 0° Aries
+var chaldeanFaces = []position.CelestialBody{
+	position.Mars, position.Sun, position.Venus, position.Mercury,
+	position.Moon, position.Saturn, position.Jupiter,
+}
+
+// Ruler returns the traditional domicile ruler of the sign
+func (z ZodiacSign) Ruler() position.CelestialBody {
+	return signRulers[z]
+}
+
+// ExaltationRuler returns the planet exalted in the sign, if any
+func (z ZodiacSign) ExaltationRuler() (position.CelestialBody, bool) {
+	body, ok := exaltations[z]
+	return body, ok
+}
+
+// Opposite returns the sign 180 degrees away
+func (z ZodiacSign) Opposite() ZodiacSign {
+	return (z + 6) % 12
+}
+
+// TriplicityRuler returns the triplicity ruler of the sign for a day or night chart
+func TriplicityRuler(sign ZodiacSign, dayChart bool) position.CelestialBody {
+	rulers := triplicityRulers[sign.Element()]
+	if dayChart {
+		return rulers[0]
+	}
+	return rulers[1]
+}
+
+// TermRuler returns the Egyptian term ruler at an ecliptic longitude
+func TermRuler(longitude float64) position.CelestialBody {
+	zp := LongitudeToZodiac(longitude)
+	for _, t := range egyptianTerms[zp.Sign] {
+		if zp.Total < t.end {
+			return t.ruler
+		}
+	}
+	return egyptianTerms[zp.Sign][4].ruler
+}
+
+// FaceRuler returns the Chaldean face (decan) ruler at an ecliptic longitude
+func FaceRuler(longitude float64) position.CelestialBody {
+	decan := int(position.NormalizeAngle(longitude) / 10)
+	return chaldeanFaces[decan%len(chaldeanFaces)]
+}
+
+// HasDignities reports whether the body takes part in the traditional dignity scheme
+func HasDignities(body position.CelestialBody) bool {
+	return body.IsMainPlanet()
+}
+
+// Dignities holds the essential dignities of a body at its position
+type Dignities struct {
+	Body  position.CelestialBody
+	Sign  ZodiacSign
+	Has   map[DignityType]bool
+	Score int
+}
+
+// List returns the dignities held, in traditional order
+func (d Dignities) List() []DignityType {
+	var result []DignityType
+	for t := Domicile; t <= Peregrine; t++ {
+		if d.Has[t] {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// EssentialDignities scores a body at an ecliptic longitude
+func EssentialDignities(body position.CelestialBody, longitude float64, dayChart bool) Dignities {
+	sign := LongitudeToZodiac(longitude).Sign
+	d := Dignities{
+		Body: body,
+		Sign: sign,
+		Has:  make(map[DignityType]bool),
+	}
+	if !HasDignities(body) {
+		return d
+	}
+
+	if sign.Ruler() == body {
+		d.Has[Domicile] = true
+	}
+	if ex, ok := sign.ExaltationRuler(); ok && ex == body {
+		d.Has[Exaltation] = true
+	}
+	if TriplicityRuler(sign, dayChart) == body {
+		d.Has[Triplicity] = true
+	}
+	if TermRuler(longitude) == body {
+		d.Has[Term] = true
+	}
+	if FaceRuler(longitude) == body {
+		d.Has[Face] = true
+	}
+	if sign.Opposite().Ruler() == body {
+		d.Has[Detriment] = true
+	}
+	if ex, ok := sign.Opposite().ExaltationRuler(); ok && ex == body {
+		d.Has[Fall] = true
+	}
+
+	essential := false
+	for t := Domicile; t <= Face; t++ {
+		if d.Has[t] {
+			essential = true
+			break
+		}
+	}
+	if !essential {
+		d.Has[Peregrine] = true
+	}
+
+	for t, ok := range d.Has {
+		if ok {
+			d.Score += t.Score()
+		}
+	}
+	return d
+}
+
+// Reception describes two planets in each other's signs of dignity
+type Reception struct {
+	Body1 position.CelestialBody
+	Body2 position.CelestialBody
+	Type  DignityType // Domicile or Exaltation
+}
+
+// IsDayChart reports whether the Sun is above the horizon, i.e. on the
+// half of the ecliptic running from the Descendant through the MC to the Ascendant
+func (c *Chart) IsDayChart() bool {
+	sun := c.GetPosition(position.Sun)
+	if c.Houses == nil || sun == nil {
+		return true
+	}
+	return position.NormalizeAngle(sun.EclipticLongitude-c.Houses.GetAscendant()) >= 180
+}
+
+// DignityTable returns the essential dignities of each traditional planet
+func (c *Chart) DignityTable() []Dignities {
+	day := c.IsDayChart()
+	var table []Dignities
+	for _, pos := range c.Positions {
+		if !HasDignities(pos.Body) {
+			continue
+		}
+		table = append(table, EssentialDignities(pos.Body, pos.EclipticLongitude, day))
+	}
+	return table
+}
+
+// MutualReceptions finds pairs of planets in each other's domicile or exaltation
+func (c *Chart) MutualReceptions() []Reception {
+	signs := make(map[position.CelestialBody]ZodiacSign)
+	var bodies []position.CelestialBody
+	for _, pos := range c.Positions {
+		if HasDignities(pos.Body) {
+			signs[pos.Body] = LongitudeToZodiac(pos.EclipticLongitude).Sign
+			bodies = append(bodies, pos.Body)
+		}
+	}
+
+	var receptions []Reception
+	for i := 0; i < len(bodies); i++ {
+		for j := i + 1; j < len(bodies); j++ {
+			a, b := bodies[i], bodies[j]
+			if signs[a].Ruler() == b && signs[b].Ruler() == a {
+				receptions = append(receptions, Reception{Body1: a, Body2: b, Type: Domicile})
+				continue
+			}
+			exA, okA := signs[a].ExaltationRuler()
+			exB, okB := signs[b].ExaltationRuler()
+			if okA && okB && exA == b && exB == a {
+				receptions = append(receptions, Reception{Body1: a, Body2: b, Type: Exaltation})
+			}
+		}
+	}
+	return receptions
+}
+
+// ChartRuler returns the domicile ruler of the Ascendant sign
+func (c *Chart) ChartRuler() (position.CelestialBody, bool) {
+	if c.Houses == nil {
+		return 0, false
+	}
+	return LongitudeToZodiac(c.Houses.GetAscendant()).Sign.Ruler(), true
+}
+
+// Dispositor returns the domicile ruler of the sign a body occupies
+func (c *Chart) Dispositor(body position.CelestialBody) (position.CelestialBody, bool) {
+	pos := c.GetPosition(body)
+	if pos == nil {
+		return 0, false
+	}
+	return LongitudeToZodiac(pos.EclipticLongitude).Sign.Ruler(), true
+}
+
+// DispositorChain follows dispositors from a body until the chain repeats.
+// The chain starts with the body itself.
+func (c *Chart) DispositorChain(body position.CelestialBody) []position.CelestialBody {
+	chain := []position.CelestialBody{body}
+	seen := map[position.CelestialBody]bool{body: true}
+	current := body
+	for {
+		next, ok := c.Dispositor(current)
+		if !ok || seen[next] {
+			return chain
+		}
+		chain = append(chain, next)
+		seen[next] = true
+		current = next
+	}
+}
+
+// FinalDispositor returns the planet every dispositor chain ends in.
+// It exists only when a single planet in its own domicile disposes the whole chart.
+func (c *Chart) FinalDispositor() (position.CelestialBody, bool) {
+	var final position.CelestialBody
+	found := false
+	for _, pos := range c.Positions {
+		if !HasDignities(pos.Body) {
+			continue
+		}
+		chain := c.DispositorChain(pos.Body)
+		end := chain[len(chain)-1]
+		if disp, ok := c.Dispositor(end); !ok || disp != end {
+			return 0, false
+		}
+		if found && end != final {
+			return 0, false
+		}
+		final = end
+		found = true
+	}
+	return final, found
+}
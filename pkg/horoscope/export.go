@@ -0,0 +1,376 @@
+package horoscope
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Export is a serializable snapshot of a chart
+type Export struct {
+	DateTime     time.Time           `json:"datetime"`
+	Location     string              `json:"location"`
+	Latitude     float64             `json:"latitude"`
+	Longitude    float64             `json:"longitude"`
+	Heliocentric bool                `json:"heliocentric,omitempty"`
+	Harmonic     int                 `json:"harmonic,omitempty"`
+	Relocation   *RelocationExport   `json:"relocated_from,omitempty"`
+	Ascendant    *float64            `json:"ascendant,omitempty"`
+	Midheaven    *float64            `json:"midheaven,omitempty"`
+	Positions    []PositionExport    `json:"positions"`
+	Aspects      []AspectExport      `json:"aspects"`
+	FixedStars   []StarContactExport `json:"fixed_stars"`
+	Sky          []SkyExport         `json:"sky"`
+	Overview     OverviewExport      `json:"overview"`
+}
+
+// PositionExport is the exported form of a body position
+type PositionExport struct {
+	Body           string  `json:"body"`
+	Longitude      float64 `json:"longitude"`
+	Latitude       float64 `json:"latitude"`
+	RightAscension float64 `json:"right_ascension"`
+	Declination    float64 `json:"declination"`
+	OutOfBounds    bool    `json:"out_of_bounds"`
+	Sign           string  `json:"sign"`
+	Degree         float64 `json:"degree"`
+	House          int     `json:"house,omitempty"`
+	NatalHouse     int     `json:"natal_house,omitempty"`
+	Retrograde     bool    `json:"retrograde"`
+	Speed          float64 `json:"speed"`
+	LatitudeSpeed  float64 `json:"latitude_speed"`
+	Stationary     bool    `json:"stationary"`
+	SpeedClass     string  `json:"speed_class,omitempty"`
+}
+
+// RelocationExport is the exported birth place of a relocated chart
+type RelocationExport struct {
+	Location  string   `json:"location"`
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	Ascendant *float64 `json:"ascendant,omitempty"`
+	Midheaven *float64 `json:"midheaven,omitempty"`
+}
+
+// AspectExport is the exported form of an aspect
+type AspectExport struct {
+	Body1       string   `json:"body1"`
+	Body2       string   `json:"body2"`
+	Type        string   `json:"type"`
+	Orb         float64  `json:"orb"`
+	Applying    bool     `json:"applying"`
+	OrbSpeed    float64  `json:"orb_speed"`
+	DaysToExact *float64 `json:"days_to_exact,omitempty"`
+}
+
+// StarContactExport is the exported form of a fixed star contact
+type StarContactExport struct {
+	Star      string  `json:"star"`
+	Magnitude float64 `json:"magnitude"`
+	Longitude float64 `json:"longitude"`
+	Type      string  `json:"type"`
+	Point     string  `json:"point"`
+	StarEvent string  `json:"star_event,omitempty"`
+	BodyEvent string  `json:"body_event,omitempty"`
+	Orb       float64 `json:"orb"`
+}
+
+// SkyExport is the exported form of a body seen from the chart location
+type SkyExport struct {
+	Body         string     `json:"body"`
+	Altitude     float64    `json:"altitude"`
+	Azimuth      float64    `json:"azimuth"`
+	AboveHorizon bool       `json:"above_horizon"`
+	Rise         *time.Time `json:"rise,omitempty"`
+	Transit      time.Time  `json:"transit"`
+	Set          *time.Time `json:"set,omitempty"`
+}
+
+// OverviewExport is the exported form of the chart overview
+type OverviewExport struct {
+	Elements    map[string]float64 `json:"elements"`
+	Modalities  map[string]float64 `json:"modalities"`
+	Element     string             `json:"dominant_element"`
+	Modality    string             `json:"dominant_modality"`
+	Hemispheres *HemispheresExport `json:"hemispheres,omitempty"`
+	Shape       string             `json:"shape"`
+	Leader      string             `json:"leader,omitempty"`
+}
+
+// HemispheresExport is the number of planets per hemisphere and quadrant
+type HemispheresExport struct {
+	Eastern   int    `json:"eastern"`
+	Western   int    `json:"western"`
+	Northern  int    `json:"northern"`
+	Southern  int    `json:"southern"`
+	Quadrants [4]int `json:"quadrants"`
+}
+
+// MidpointsExport is the exported form of the midpoints of a chart
+type MidpointsExport struct {
+	DateTime  time.Time            `json:"datetime"`
+	Location  string               `json:"location"`
+	Harmonic  int                  `json:"harmonic,omitempty"`
+	Orb       float64              `json:"orb"`
+	Midpoints []MidpointExport     `json:"midpoints"`
+	Trees     []MidpointTreeExport `json:"trees"`
+}
+
+// MidpointExport is the exported form of a midpoint
+type MidpointExport struct {
+	Body1     string  `json:"body1"`
+	Body2     string  `json:"body2"`
+	Longitude float64 `json:"longitude"`
+	Dial      float64 `json:"dial"`
+	Orb       float64 `json:"orb,omitempty"`
+}
+
+// MidpointTreeExport is the exported form of a midpoint tree
+type MidpointTreeExport struct {
+	Focus     string           `json:"focus"`
+	Longitude float64          `json:"longitude"`
+	Dial      float64          `json:"dial"`
+	Contacts  []MidpointExport `json:"contacts"`
+}
+
+// PlanetaryDayExport is the exported form of a planetary day
+type PlanetaryDayExport struct {
+	Ruler       string                `json:"ruler"`
+	Sunrise     time.Time             `json:"sunrise"`
+	Sunset      time.Time             `json:"sunset"`
+	NextSunrise time.Time             `json:"next_sunrise"`
+	Hours       []PlanetaryHourExport `json:"hours"`
+}
+
+// PlanetaryHourExport is the exported form of a planetary hour
+type PlanetaryHourExport struct {
+	Number int       `json:"number"`
+	Night  bool      `json:"night"`
+	Ruler  string    `json:"ruler"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+// Export builds the serializable snapshot of the chart
+func (c *Chart) Export() Export {
+	e := Export{
+		DateTime:     c.DateTime,
+		Location:     c.Location,
+		Latitude:     c.Latitude,
+		Longitude:    c.Longitude,
+		Heliocentric: c.Heliocentric,
+		Harmonic:     c.Harmonic,
+		Positions:    make([]PositionExport, 0, len(c.Positions)),
+		Aspects:      make([]AspectExport, 0, len(c.Aspects)),
+		FixedStars:   make([]StarContactExport, 0, len(c.StarContacts)),
+		Sky:          make([]SkyExport, 0, len(c.Sky)),
+	}
+
+	if c.Houses != nil {
+		asc, mc := c.Houses.GetAscendant(), c.Houses.GetMC()
+		e.Ascendant = &asc
+		e.Midheaven = &mc
+	}
+
+	if r := c.Relocation; r != nil {
+		e.Relocation = &RelocationExport{
+			Location:  r.Location,
+			Latitude:  r.Latitude,
+			Longitude: r.Longitude,
+		}
+		if r.Houses != nil {
+			asc, mc := r.Houses.GetAscendant(), r.Houses.GetMC()
+			e.Relocation.Ascendant = &asc
+			e.Relocation.Midheaven = &mc
+		}
+	}
+
+	for _, pos := range c.Positions {
+		zp := LongitudeToZodiac(pos.EclipticLongitude)
+		pe := PositionExport{
+			Body:           pos.Body.String(),
+			Longitude:      pos.EclipticLongitude,
+			Latitude:       pos.EclipticLatitude,
+			RightAscension: pos.RightAscension,
+			Declination:    pos.Declination,
+			OutOfBounds:    pos.IsOutOfBounds(),
+			Sign:           zp.Sign.String(),
+			Degree:         zp.Total,
+			House:          c.BodyInHouse(pos.Body),
+			Retrograde:     pos.Retrograde,
+			Speed:          pos.Speed,
+			LatitudeSpeed:  pos.LatitudeSpeed,
+			Stationary:     pos.Stationary,
+		}
+		if c.Relocation != nil {
+			pe.NatalHouse = c.NatalHouse(pos.Body)
+		}
+		if _, ok := position.MeanDailyMotion(pos.Body); ok {
+			pe.SpeedClass = pos.SpeedClass().String()
+		}
+		e.Positions = append(e.Positions, pe)
+	}
+
+	for _, a := range c.Aspects {
+		ae := AspectExport{
+			Body1:    a.Body1.String(),
+			Body2:    a.Body2.String(),
+			Type:     a.Type.String(),
+			Orb:      a.Orb,
+			Applying: a.Applying,
+			OrbSpeed: a.OrbSpeed,
+		}
+		if days, ok := a.DaysToExact(); ok {
+			ae.DaysToExact = &days
+		}
+		e.Aspects = append(e.Aspects, ae)
+	}
+
+	for _, s := range c.StarContacts {
+		sc := StarContactExport{
+			Star:      s.Star.Name,
+			Magnitude: s.Star.Magnitude,
+			Longitude: s.Longitude,
+			Type:      s.Type.String(),
+			Point:     s.Point(),
+			Orb:       s.Orb,
+		}
+		if s.Type == StarParan {
+			sc.StarEvent = s.StarEvent.String()
+			sc.BodyEvent = s.BodyEvent.String()
+		}
+		e.FixedStars = append(e.FixedStars, sc)
+	}
+
+	for _, s := range c.Sky {
+		se := SkyExport{
+			Body:         s.Body.String(),
+			Altitude:     s.Altitude,
+			Azimuth:      s.Azimuth,
+			AboveHorizon: s.AboveHorizon,
+			Transit:      s.Transit,
+		}
+		if s.HasRise {
+			rise := s.Rise
+			se.Rise = &rise
+		}
+		if s.HasSet {
+			set := s.Set
+			se.Set = &set
+		}
+		e.Sky = append(e.Sky, se)
+	}
+
+	e.Overview = c.Overview().Export()
+	return e
+}
+
+// Export builds the serializable form of the overview
+func (o Overview) Export() OverviewExport {
+	e := OverviewExport{
+		Elements:   make(map[string]float64),
+		Modalities: make(map[string]float64),
+		Element:    o.Element.String(),
+		Modality:   o.Modality.String(),
+		Shape:      o.Shape.String(),
+	}
+	for el, points := range o.Elements {
+		e.Elements[el.String()] = points
+	}
+	for m, points := range o.Modalities {
+		e.Modalities[m.String()] = points
+	}
+	if o.HasHouses {
+		e.Hemispheres = &HemispheresExport{
+			Eastern:   o.Eastern,
+			Western:   o.Western,
+			Northern:  o.Northern,
+			Southern:  o.Southern,
+			Quadrants: o.Quadrants,
+		}
+	}
+	if o.HasLeader {
+		e.Leader = o.Leader.String()
+	}
+	return e
+}
+
+// WriteJSON writes the chart export as indented JSON
+func (c *Chart) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.Export())
+}
+
+// ExportMidpoints builds the serializable list of midpoints, sorted on the
+// 90° dial, and the midpoint trees within orb
+func (c *Chart) ExportMidpoints(orb float64) MidpointsExport {
+	e := MidpointsExport{
+		DateTime: c.DateTime,
+		Location: c.Location,
+		Harmonic: c.Harmonic,
+		Orb:      orb,
+		Trees:    make([]MidpointTreeExport, 0),
+	}
+	for _, m := range c.Midpoints() {
+		e.Midpoints = append(e.Midpoints, exportMidpoint(m, 0))
+	}
+	for _, t := range c.MidpointTrees(orb) {
+		te := MidpointTreeExport{
+			Focus:     t.Focus.String(),
+			Longitude: t.Longitude,
+			Dial:      DialPosition(t.Longitude),
+		}
+		for _, contact := range t.Contacts {
+			te.Contacts = append(te.Contacts, exportMidpoint(contact.Midpoint, contact.Orb))
+		}
+		e.Trees = append(e.Trees, te)
+	}
+	return e
+}
+
+func exportMidpoint(m Midpoint, orb float64) MidpointExport {
+	return MidpointExport{
+		Body1:     m.Body1.String(),
+		Body2:     m.Body2.String(),
+		Longitude: m.Longitude,
+		Dial:      m.Dial(),
+		Orb:       orb,
+	}
+}
+
+// WriteMidpointsJSON writes the midpoint export as indented JSON
+func (c *Chart) WriteMidpointsJSON(w io.Writer, orb float64) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.ExportMidpoints(orb))
+}
+
+// Export builds the serializable form of the planetary day
+func (d PlanetaryDay) Export() PlanetaryDayExport {
+	e := PlanetaryDayExport{
+		Ruler:       d.Ruler.String(),
+		Sunrise:     d.Sunrise,
+		Sunset:      d.Sunset,
+		NextSunrise: d.NextSunrise,
+	}
+	for _, h := range d.Hours {
+		e.Hours = append(e.Hours, PlanetaryHourExport{
+			Number: h.Number,
+			Night:  h.Night,
+			Ruler:  h.Ruler.String(),
+			Start:  h.Start,
+			End:    h.End,
+		})
+	}
+	return e
+}
+
+// WriteJSON writes the planetary day export as indented JSON
+func (d PlanetaryDay) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d.Export())
+}
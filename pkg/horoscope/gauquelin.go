@@ -0,0 +1,40 @@
+package horoscope
+
+import (
+	"math"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// GauquelinSectorCount is the number of Gauquelin sectors
+const GauquelinSectorCount = 36
+
+// GauquelinSector returns the Gauquelin sector (1-36) of a body. Sectors
+// divide the diurnal and nocturnal semi-arcs into 18 parts each, numbered
+// from the rising point through the upper culmination (end of sector 9), the
+// setting (sector 19) and the lower culmination. Circumpolar and never-rising
+// bodies have no sector.
+func (c *Chart) GauquelinSector(body position.CelestialBody) (int, bool) {
+	pos := c.GetPosition(body)
+	if pos == nil || c.Heliocentric {
+		return 0, false
+	}
+
+	cosH := -math.Tan(position.DegreesToRadians(c.Latitude)) * math.Tan(position.DegreesToRadians(pos.Declination))
+	if cosH <= -1 || cosH >= 1 {
+		return 0, false
+	}
+	dsa := position.RadiansToDegrees(math.Acos(cosH)) // diurnal semi-arc
+	nsa := 180 - dsa
+
+	lst := position.LocalSiderealTime(position.JulianDay(c.DateTime), c.Longitude)
+	h := position.NormalizeAngle(lst - pos.RightAscension + dsa) // degrees since rising
+
+	var sector int
+	if h < 2*dsa {
+		sector = 1 + int(h/(2*dsa)*18)
+	} else {
+		sector = 19 + int((h-2*dsa)/(2*nsa)*18)
+	}
+	return min(sector, GauquelinSectorCount), true
+}
@@ -0,0 +1,32 @@
+package horoscope
+
+import (
+	"math"
+	"slices"
+)
+
+// HarmonicChart builds the nth harmonic chart: every longitude and speed is
+// multiplied by n and the longitude aspects are recomputed. Harmonic charts
+// have no houses, and latitudes and declinations are those of the radix, so
+// declination aspects are left out.
+func (c *Chart) HarmonicChart(n int, orbs Orbs) *Chart {
+	positions := slices.Clone(c.Positions)
+	for i := range positions {
+		positions[i].EclipticLongitude = math.Mod(math.Mod(positions[i].EclipticLongitude*float64(n), 360)+360, 360)
+		positions[i].Speed *= float64(n)
+	}
+
+	aspects := slices.DeleteFunc(CalculateAspects(positions, orbs), func(a Aspect) bool {
+		return a.Type.IsDeclination()
+	})
+	return &Chart{
+		DateTime:     c.DateTime,
+		Latitude:     c.Latitude,
+		Longitude:    c.Longitude,
+		Location:     c.Location,
+		Heliocentric: c.Heliocentric,
+		Harmonic:     n,
+		Positions:    positions,
+		Aspects:      aspects,
+	}
+}
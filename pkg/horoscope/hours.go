@@ -0,0 +1,150 @@
+package horoscope
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// PlanetaryHour is one of the twelve unequal hours of daylight or of night
+type PlanetaryHour struct {
+	Number int // 1 to 12 within the day or the night
+	Night  bool
+	Ruler  position.CelestialBody
+	Start  time.Time
+	End    time.Time
+}
+
+// Remaining returns how long the hour still runs after t
+func (h PlanetaryHour) Remaining(t time.Time) time.Duration {
+	return h.End.Sub(t)
+}
+
+// PlanetaryDay runs from one sunrise to the next and is ruled by the
+// planet of its weekday, which also rules its first hour
+type PlanetaryDay struct {
+	Ruler       position.CelestialBody
+	Sunrise     time.Time
+	Sunset      time.Time
+	NextSunrise time.Time
+	Hours       [24]PlanetaryHour // Twelve day hours then twelve night hours
+}
+
+// ErrNoSunrise is returned when the Sun does not both rise and set around
+// a date, as near the poles
+var ErrNoSunrise = errors.New("the Sun does not rise and set at this place on this date")
+
+// dayRulers are the rulers of the weekdays, from Sunday
+var dayRulers = [7]position.CelestialBody{
+	position.Sun, position.Moon, position.Mars, position.Mercury,
+	position.Jupiter, position.Venus, position.Saturn,
+}
+
+// PlanetaryDayAt returns the planetary day running at t at a place, which
+// began at the last sunrise. The weekday of that sunrise at the place, in
+// local mean time, gives the day ruler, whatever t's location.
+func PlanetaryDayAt(t time.Time, latitude, longitude float64) (PlanetaryDay, error) {
+	rises, sets := sunEvents(t, latitude, longitude)
+
+	var day PlanetaryDay
+	for _, r := range rises {
+		if !r.After(t) {
+			day.Sunrise = r
+		}
+	}
+	if day.Sunrise.IsZero() {
+		return PlanetaryDay{}, ErrNoSunrise
+	}
+	day.Sunset = firstAfter(sets, day.Sunrise)
+	if day.Sunset.IsZero() {
+		return PlanetaryDay{}, ErrNoSunrise
+	}
+	day.NextSunrise = firstAfter(rises, day.Sunset)
+	if day.NextSunrise.IsZero() {
+		return PlanetaryDay{}, ErrNoSunrise
+	}
+
+	day.Ruler = dayRulers[LocalMeanTime(day.Sunrise, longitude).Weekday()]
+	first := 0
+	for i, body := range chaldeanOrder {
+		if body == day.Ruler {
+			first = i
+		}
+	}
+
+	dayHour := day.Sunset.Sub(day.Sunrise) / 12
+	nightHour := day.NextSunrise.Sub(day.Sunset) / 12
+	for i := range day.Hours {
+		h := PlanetaryHour{Number: i%12 + 1, Night: i >= 12, Ruler: chaldeanOrder[(first+i)%7]}
+		if h.Night {
+			h.Start = day.Sunset.Add(time.Duration(i-12) * nightHour)
+			h.End = h.Start.Add(nightHour)
+		} else {
+			h.Start = day.Sunrise.Add(time.Duration(i) * dayHour)
+			h.End = h.Start.Add(dayHour)
+		}
+		day.Hours[i] = h
+	}
+	// Close the last hours on the sunset and sunrise themselves
+	day.Hours[11].End = day.Sunset
+	day.Hours[23].End = day.NextSunrise
+	return day, nil
+}
+
+// PlanetaryDayFor returns the planetary day beginning at the sunrise of
+// a calendar date at the place
+func PlanetaryDayFor(date time.Time, latitude, longitude float64) (PlanetaryDay, error) {
+	local := LocalMeanTime(date, longitude)
+	noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, local.Location())
+	sky := position.Observe(position.Sun, latitude, longitude, noon)
+	if !sky.HasRise {
+		return PlanetaryDay{}, ErrNoSunrise
+	}
+	return PlanetaryDayAt(sky.Rise.In(date.Location()), latitude, longitude)
+}
+
+// HourAt returns the hour running at t, if t falls within the day
+func (d PlanetaryDay) HourAt(t time.Time) (PlanetaryHour, bool) {
+	for _, h := range d.Hours {
+		if !t.Before(h.Start) && t.Before(h.End) {
+			return h, true
+		}
+	}
+	return PlanetaryHour{}, false
+}
+
+// LocalMeanTime returns t in the local mean time of a longitude, which
+// gives the calendar date at the place
+func LocalMeanTime(t time.Time, longitude float64) time.Time {
+	offset := time.Duration(longitude / 15 * float64(time.Hour))
+	return t.In(time.FixedZone("LMT", int(offset.Seconds())))
+}
+
+// sunEvents returns the sunrises and sunsets of the calendar days at the
+// place before, of and after t, in t's location, in chronological order
+func sunEvents(t time.Time, latitude, longitude float64) ([]time.Time, []time.Time) {
+	local := LocalMeanTime(t, longitude)
+	var rises, sets []time.Time
+	for offset := -1; offset <= 1; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 12, 0, 0, 0, local.Location())
+		sky := position.Observe(position.Sun, latitude, longitude, day)
+		if sky.HasRise {
+			rises = append(rises, sky.Rise.In(t.Location()))
+		}
+		if sky.HasSet {
+			sets = append(sets, sky.Set.In(t.Location()))
+		}
+	}
+	return rises, sets
+}
+
+// firstAfter returns the first time after t, or the zero time
+func firstAfter(times []time.Time, t time.Time) time.Time {
+	for _, x := range times {
+		if x.After(t) {
+			return x
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,41 @@
+package horoscope
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// The day ruler follows the weekday at the place, not in the caller's zone
+func TestPlanetaryDayRulerAtPlace(t *testing.T) {
+	const lat, lon = 35.68, 139.69 // Tokyo
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("no time zone database")
+	}
+	// Monday 2026-10-19, mid-morning in Tokyo
+	instant := time.Date(2026, 10, 19, 10, 0, 0, 0, tokyo)
+
+	for _, name := range []string{"Asia/Tokyo", "Europe/Paris", "America/New_York", "UTC"} {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		day, err := PlanetaryDayAt(instant.In(loc), lat, lon)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if day.Ruler != position.Moon {
+			t.Errorf("%s: ruler %s, want Moon", name, day.Ruler)
+		}
+
+		day, err = PlanetaryDayFor(time.Date(2026, 10, 19, 0, 0, 0, 0, loc), lat, lon)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if day.Ruler != position.Moon {
+			t.Errorf("%s: ruler of the date %s, want Moon", name, day.Ruler)
+		}
+	}
+}
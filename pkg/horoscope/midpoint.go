@@ -0,0 +1,130 @@
+package horoscope
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// MidpointBodies are the factors combined into midpoints: the planets and
+// the North Node, as in Cosmobiology and Uranian astrology
+var MidpointBodies = []position.CelestialBody{
+	position.Sun, position.Moon, position.Mercury, position.Venus, position.Mars,
+	position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto,
+	position.NorthNode,
+}
+
+// DefaultMidpointOrb is the orb in degrees of the 90° dial for a body to
+// occupy a midpoint
+const DefaultMidpointOrb = 1.5
+
+// DialSpan is the length of the 90° dial, on which the conjunction, square,
+// opposition and semi-square of the zodiac all fall together
+const DialSpan = 90.0
+
+// Midpoint is the point halfway between two bodies along the shorter arc
+type Midpoint struct {
+	Body1     position.CelestialBody
+	Body2     position.CelestialBody
+	Longitude float64
+}
+
+// Dial returns the position of the midpoint on the 90° dial
+func (m Midpoint) Dial() float64 {
+	return DialPosition(m.Longitude)
+}
+
+// Involves reports whether a body is one of the midpoint's factors
+func (m Midpoint) Involves(body position.CelestialBody) bool {
+	return m.Body1 == body || m.Body2 == body
+}
+
+// DialPosition folds an ecliptic longitude onto the 90° dial
+func DialPosition(longitude float64) float64 {
+	return math.Mod(math.Mod(longitude, DialSpan)+DialSpan, DialSpan)
+}
+
+// DialDistance returns the separation of two longitudes on the 90° dial
+// (at most 45°)
+func DialDistance(a, b float64) float64 {
+	d := math.Abs(DialPosition(a) - DialPosition(b))
+	return math.Min(d, DialSpan-d)
+}
+
+// Midpoints returns the midpoints of every pair of MidpointBodies in the
+// chart, sorted by their position on the 90° dial
+func (c *Chart) Midpoints() []Midpoint {
+	var bodies []position.Position
+	for _, body := range MidpointBodies {
+		if pos := c.GetPosition(body); pos != nil {
+			bodies = append(bodies, *pos)
+		}
+	}
+
+	var midpoints []Midpoint
+	for i := 0; i < len(bodies); i++ {
+		for j := i + 1; j < len(bodies); j++ {
+			midpoints = append(midpoints, Midpoint{
+				Body1:     bodies[i].Body,
+				Body2:     bodies[j].Body,
+				Longitude: midpointLongitude(bodies[i].EclipticLongitude, bodies[j].EclipticLongitude),
+			})
+		}
+	}
+	sort.SliceStable(midpoints, func(i, j int) bool {
+		return midpoints[i].Dial() < midpoints[j].Dial()
+	})
+	return midpoints
+}
+
+// midpointLongitude returns the near midpoint of two longitudes
+func midpointLongitude(a, b float64) float64 {
+	diff := position.NormalizeMotion(b - a)
+	return math.Mod(a+diff/2+360, 360)
+}
+
+// MidpointContact is a midpoint occupied by the focus of a tree
+type MidpointContact struct {
+	Midpoint
+	Orb float64 // Distance on the 90° dial
+}
+
+// MidpointTree lists the midpoints that a body occupies on the 90° dial
+type MidpointTree struct {
+	Focus     position.CelestialBody
+	Longitude float64
+	Contacts  []MidpointContact // Tightest first
+}
+
+// MidpointTrees returns, for each of the MidpointBodies, the midpoints of
+// other pairs that fall within orb of it on the 90° dial. Bodies on no
+// midpoint are left out.
+func (c *Chart) MidpointTrees(orb float64) []MidpointTree {
+	midpoints := c.Midpoints()
+
+	var trees []MidpointTree
+	for _, body := range MidpointBodies {
+		pos := c.GetPosition(body)
+		if pos == nil {
+			continue
+		}
+		tree := MidpointTree{Focus: body, Longitude: pos.EclipticLongitude}
+		for _, m := range midpoints {
+			if m.Involves(body) {
+				continue
+			}
+			if d := DialDistance(pos.EclipticLongitude, m.Longitude); d <= orb {
+				tree.Contacts = append(tree.Contacts, MidpointContact{Midpoint: m, Orb: d})
+			}
+		}
+		if len(tree.Contacts) == 0 {
+			continue
+		}
+		sort.SliceStable(tree.Contacts, func(i, j int) bool {
+			return tree.Contacts[i].Orb < tree.Contacts[j].Orb
+		})
+		trees = append(trees, tree)
+	}
+	return trees
+}
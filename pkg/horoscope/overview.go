@@ -0,0 +1,212 @@
+package horoscope
+
+import (
+	"sort"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// ChartShape is the overall distribution of the planets around the wheel,
+// after Marc Edmund Jones
+type ChartShape int
+
+// Jones chart shapes.
+const (
+	Bundle     ChartShape = iota // Every planet within a trine
+	Bowl                         // Every planet within an opposition
+	Bucket                       // A bowl with a single planet, the handle, opposite
+	Locomotive                   // Every planet within 240°, leaving an empty trine
+	Seesaw                       // Two groups facing each other across two empty spaces
+	Splay                        // Three or more irregular groups
+	Splash                       // Planets spread evenly around the wheel
+)
+
+// String returns the name of the shape
+func (s ChartShape) String() string {
+	return shapeNames[s]
+}
+
+var shapeNames = map[ChartShape]string{
+	Bundle:     "Bundle",
+	Bowl:       "Bowl",
+	Bucket:     "Bucket",
+	Locomotive: "Locomotive",
+	Seesaw:     "Seesaw",
+	Splay:      "Splay",
+	Splash:     "Splash",
+}
+
+// overviewBodies are the planets whose distribution is analysed
+var overviewBodies = []position.CelestialBody{
+	position.Sun, position.Moon, position.Mercury, position.Venus, position.Mars,
+	position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto,
+}
+
+// balanceWeights are the points each body adds to the element and modality
+// of its sign: the luminaries count double, the slow generational planets
+// half
+var balanceWeights = map[position.CelestialBody]float64{
+	position.Sun: 2, position.Moon: 2,
+	position.Mercury: 1, position.Venus: 1, position.Mars: 1, position.Jupiter: 1, position.Saturn: 1,
+	position.Uranus: 0.5, position.Neptune: 0.5, position.Pluto: 0.5,
+}
+
+// angleWeight is the points added by the Ascendant and the Midheaven
+const angleWeight = 2
+
+// Overview summarises the balance and distribution of a chart
+type Overview struct {
+	Elements   map[Element]float64 // Weighted points per element
+	Modalities map[Modality]float64
+	Element    Element // Dominant element
+	Modality   Modality
+
+	// Planets per hemisphere and quadrant, when the chart has houses.
+	// The eastern half runs from the MC to the IC through the Ascendant
+	// (houses 10 to 3), the northern half is below the horizon (houses 1
+	// to 6). Quadrant I holds houses 1 to 3.
+	HasHouses bool
+	Eastern   int
+	Western   int
+	Northern  int
+	Southern  int
+	Quadrants [4]int
+
+	Shape ChartShape
+	// Leader is the handle of a bucket, or the planet rising first after
+	// the empty space of a bundle, bowl or locomotive
+	Leader    position.CelestialBody
+	HasLeader bool
+}
+
+// Overview analyses the element and modality balance, the hemisphere and
+// quadrant emphasis and the Jones shape of the chart
+func (c *Chart) Overview() Overview {
+	o := Overview{
+		Elements:   make(map[Element]float64),
+		Modalities: make(map[Modality]float64),
+		HasHouses:  c.Houses != nil,
+	}
+
+	var longitudes []float64
+	var bodies []position.CelestialBody
+	for _, body := range overviewBodies {
+		pos := c.GetPosition(body)
+		if pos == nil {
+			continue
+		}
+		o.addSign(LongitudeToZodiac(pos.EclipticLongitude).Sign, balanceWeights[body])
+		longitudes = append(longitudes, pos.EclipticLongitude)
+		bodies = append(bodies, body)
+		if o.HasHouses {
+			o.addHouse(c.Houses.GetHouse(pos.EclipticLongitude))
+		}
+	}
+	if o.HasHouses {
+		o.addSign(LongitudeToZodiac(c.Houses.GetAscendant()).Sign, angleWeight)
+		o.addSign(LongitudeToZodiac(c.Houses.GetMC()).Sign, angleWeight)
+	}
+
+	for e := Fire; e <= Water; e++ {
+		if o.Elements[e] > o.Elements[o.Element] {
+			o.Element = e
+		}
+	}
+	for m := Cardinal; m <= Mutable; m++ {
+		if o.Modalities[m] > o.Modalities[o.Modality] {
+			o.Modality = m
+		}
+	}
+
+	o.Shape, o.Leader, o.HasLeader = chartShape(longitudes, bodies)
+	return o
+}
+
+func (o *Overview) addSign(sign ZodiacSign, weight float64) {
+	o.Elements[sign.Element()] += weight
+	o.Modalities[sign.Modality()] += weight
+}
+
+func (o *Overview) addHouse(house int) {
+	if house < 1 {
+		return
+	}
+	if house >= 10 || house <= 3 {
+		o.Eastern++
+	} else {
+		o.Western++
+	}
+	if house <= 6 {
+		o.Northern++
+	} else {
+		o.Southern++
+	}
+	o.Quadrants[(house-1)/3]++
+}
+
+// Thresholds of the empty spaces that define the shapes
+const (
+	shapeSeparation = 60.0 // Empty space separating two groups
+	shapeHandle     = 60.0 // Empty space on each side of a bucket handle
+)
+
+// chartShape classifies the distribution of the planets at the given
+// longitudes, returning the leading planet or handle when the shape has one
+func chartShape(longitudes []float64, bodies []position.CelestialBody) (ChartShape, position.CelestialBody, bool) {
+	n := len(longitudes)
+	if n < 3 {
+		return Splash, 0, false
+	}
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return longitudes[order[a]] < longitudes[order[b]] })
+
+	// gaps[i] is the empty arc from the ith planet to the next in zodiacal order
+	gaps := make([]float64, n)
+	largest := 0
+	for i := range gaps {
+		gaps[i] = position.NormalizeAngle(longitudes[order[(i+1)%n]] - longitudes[order[i]])
+		if gaps[i] > gaps[largest] {
+			largest = i
+		}
+	}
+	// The planets rise in zodiacal order, so the first one after the empty
+	// space leads the others
+	leader := bodies[order[(largest+1)%n]]
+
+	switch {
+	case gaps[largest] >= 240:
+		return Bundle, leader, true
+	case gaps[largest] >= 180:
+		return Bowl, leader, true
+	}
+	for i := range gaps {
+		before, after := gaps[(i-1+n)%n], gaps[i]
+		if before+after >= 180 && before >= shapeHandle && after >= shapeHandle {
+			return Bucket, bodies[order[i]], true
+		}
+	}
+	var separations []int
+	for i, gap := range gaps {
+		if gap >= shapeSeparation {
+			separations = append(separations, i)
+		}
+	}
+	switch {
+	case len(separations) == 1 && gaps[largest] >= 120:
+		// A single empty space: the other planets follow each other closely
+		return Locomotive, leader, true
+	case len(separations) >= 3:
+		return Splay, 0, false
+	case len(separations) == 2:
+		// Both groups need at least two planets to face each other
+		first := separations[1] - separations[0]
+		if first >= 2 && n-first >= 2 {
+			return Seesaw, 0, false
+		}
+		return Splay, 0, false
+	}
+	return Splash, 0, false
+}
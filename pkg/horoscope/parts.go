@@ -0,0 +1,46 @@
+package horoscope
+
+import (
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// ArabicParts computes the Hellenistic lots from the Ascendant and planet positions.
+// Formulas are reversed for night charts, following Paulus Alexandrinus.
+func ArabicParts(positions []position.Position, ascendant float64, dayChart bool) []position.Position {
+	lon := make(map[position.CelestialBody]float64)
+	for _, pos := range positions {
+		lon[pos.Body] = pos.EclipticLongitude
+	}
+
+	// lot returns Asc + a - b by day, Asc + b - a by night
+	lot := func(a, b float64) float64 {
+		if dayChart {
+			return position.NormalizeAngle(ascendant + a - b)
+		}
+		return position.NormalizeAngle(ascendant + b - a)
+	}
+
+	fortune := lot(lon[position.Moon], lon[position.Sun])
+	spirit := lot(lon[position.Sun], lon[position.Moon])
+	eros := lot(lon[position.Venus], spirit)
+	necessity := lot(fortune, lon[position.Mercury])
+
+	return []position.Position{
+		position.Position{Body: position.PartOfFortune, EclipticLongitude: fortune}.WithEquatorial(),
+		position.Position{Body: position.PartOfSpirit, EclipticLongitude: spirit}.WithEquatorial(),
+		position.Position{Body: position.PartOfEros, EclipticLongitude: eros}.WithEquatorial(),
+		position.Position{Body: position.PartOfNecessity, EclipticLongitude: necessity}.WithEquatorial(),
+	}
+}
+
+// AddCalculatedPoints appends the angular points and Arabic Parts to the chart positions.
+// The chart houses must be set, since both depend on the Ascendant and on sect.
+func (c *Chart) AddCalculatedPoints() {
+	if c.Houses == nil {
+		return
+	}
+	points := position.CalculateAngularPoints(c.Latitude, c.Longitude, c.DateTime)
+	parts := ArabicParts(c.Positions, c.Houses.GetAscendant(), c.IsDayChart())
+	c.Positions = append(c.Positions, points...)
+	c.Positions = append(c.Positions, parts...)
+}
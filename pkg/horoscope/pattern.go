@@ -0,0 +1,381 @@
+package horoscope
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// PatternType represents a configuration formed by several aspects
+type PatternType int
+
+// Aspect patterns and stellia.
+const (
+	GrandTrine PatternType = iota
+	TSquare
+	GrandCross
+	Yod
+	Kite
+	MysticRectangle
+	StelliumSign
+	StelliumHouse
+)
+
+// String returns the name of the pattern
+func (p PatternType) String() string {
+	return patternNames[p]
+}
+
+// IsHarmonic returns true if the pattern is made of flowing aspects
+func (p PatternType) IsHarmonic() bool {
+	return p == GrandTrine || p == Kite || p == MysticRectangle
+}
+
+var patternNames = map[PatternType]string{
+	GrandTrine:      "Grand Trine",
+	TSquare:         "T-Square",
+	GrandCross:      "Grand Cross",
+	Yod:             "Yod",
+	Kite:            "Kite",
+	MysticRectangle: "Mystic Rectangle",
+	StelliumSign:    "Stellium (sign)",
+	StelliumHouse:   "Stellium (house)",
+}
+
+// StelliumMinBodies is the number of bodies needed to form a stellium
+const StelliumMinBodies = 3
+
+// Pattern is an aspect configuration found in a chart
+type Pattern struct {
+	Type      PatternType
+	Bodies    []position.CelestialBody
+	Apex      position.CelestialBody // Focal body (T-square, Yod, Kite)
+	HasApex   bool
+	Sign      ZodiacSign // Sign of a sign stellium
+	House     int        // House of a house stellium
+	Tightness float64    // Mean orb of the aspects, or arc spanned by a stellium
+}
+
+// Contains reports whether the body is part of the pattern
+func (p Pattern) Contains(body position.CelestialBody) bool {
+	for _, b := range p.Bodies {
+		if b == body {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPatternBody reports whether a body is considered for pattern detection.
+// Nodes and asteroids are left out so the mean node axis does not create spurious oppositions.
+// The Earth only appears in heliocentric charts, in place of the Sun.
+func IsPatternBody(body position.CelestialBody) bool {
+	return body <= position.Pluto || body == position.Earth
+}
+
+// aspectGraph indexes longitude aspects by body pair
+type aspectGraph map[[2]position.CelestialBody]Aspect
+
+func newAspectGraph(aspects []Aspect) aspectGraph {
+	g := make(aspectGraph)
+	for _, a := range aspects {
+		if !IsPatternBody(a.Body1) || !IsPatternBody(a.Body2) || a.Type.IsDeclination() {
+			continue
+		}
+		g[pairKey(a.Body1, a.Body2)] = a
+	}
+	return g
+}
+
+func pairKey(a, b position.CelestialBody) [2]position.CelestialBody {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]position.CelestialBody{a, b}
+}
+
+// is reports whether a and b form the given aspect
+func (g aspectGraph) is(a, b position.CelestialBody, t AspectType) bool {
+	asp, ok := g[pairKey(a, b)]
+	return ok && asp.Type == t
+}
+
+// meanOrb averages the orbs of the aspects between the given pairs
+func (g aspectGraph) meanOrb(pairs ...[2]position.CelestialBody) float64 {
+	if len(pairs) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, p := range pairs {
+		sum += g[pairKey(p[0], p[1])].Orb
+	}
+	return sum / float64(len(pairs))
+}
+
+func pair(a, b position.CelestialBody) [2]position.CelestialBody {
+	return [2]position.CelestialBody{a, b}
+}
+
+// DetectPatterns finds aspect patterns and stellia among the given positions.
+// houses may be nil, in which case house stellia are not reported.
+func DetectPatterns(positions []position.Position, aspects []Aspect, houses HouseCusps) []Pattern {
+	var bodies []position.CelestialBody
+	for _, pos := range positions {
+		if IsPatternBody(pos.Body) {
+			bodies = append(bodies, pos.Body)
+		}
+	}
+	g := newAspectGraph(aspects)
+
+	var patterns []Pattern
+	patterns = append(patterns, findGrandTrines(g, bodies)...)
+	crosses := findGrandCrosses(g, bodies)
+	patterns = append(patterns, crosses...)
+	patterns = append(patterns, findTSquares(g, bodies, crosses)...)
+	patterns = append(patterns, findYods(g, bodies)...)
+	patterns = append(patterns, findKites(g, bodies)...)
+	patterns = append(patterns, findMysticRectangles(g, bodies)...)
+	patterns = append(patterns, findStellia(positions, houses)...)
+	return patterns
+}
+
+// Patterns returns the aspect patterns and stellia of the chart
+func (c *Chart) Patterns() []Pattern {
+	return DetectPatterns(c.Positions, c.Aspects, c.Houses)
+}
+
+func findGrandTrines(g aspectGraph, bodies []position.CelestialBody) []Pattern {
+	var result []Pattern
+	for i := 0; i < len(bodies); i++ {
+		for j := i + 1; j < len(bodies); j++ {
+			for k := j + 1; k < len(bodies); k++ {
+				a, b, c := bodies[i], bodies[j], bodies[k]
+				if g.is(a, b, Trine) && g.is(b, c, Trine) && g.is(a, c, Trine) {
+					result = append(result, Pattern{
+						Type:      GrandTrine,
+						Bodies:    []position.CelestialBody{a, b, c},
+						Tightness: g.meanOrb(pair(a, b), pair(b, c), pair(a, c)),
+					})
+				}
+			}
+		}
+	}
+	return result
+}
+
+func findTSquares(g aspectGraph, bodies []position.CelestialBody, crosses []Pattern) []Pattern {
+	var result []Pattern
+	for i := 0; i < len(bodies); i++ {
+		for j := i + 1; j < len(bodies); j++ {
+			a, b := bodies[i], bodies[j]
+			if !g.is(a, b, Opposition) {
+				continue
+			}
+			for _, apex := range bodies {
+				if apex == a || apex == b || !g.is(apex, a, Square) || !g.is(apex, b, Square) {
+					continue
+				}
+				members := []position.CelestialBody{a, b, apex}
+				if coveredBy(members, crosses) {
+					continue
+				}
+				result = append(result, Pattern{
+					Type:      TSquare,
+					Bodies:    members,
+					Apex:      apex,
+					HasApex:   true,
+					Tightness: g.meanOrb(pair(a, b), pair(apex, a), pair(apex, b)),
+				})
+			}
+		}
+	}
+	return result
+}
+
+func findGrandCrosses(g aspectGraph, bodies []position.CelestialBody) []Pattern {
+	var result []Pattern
+	oppositions := findOppositions(g, bodies)
+	for i := 0; i < len(oppositions); i++ {
+		for j := i + 1; j < len(oppositions); j++ {
+			a, b := oppositions[i][0], oppositions[i][1]
+			c, d := oppositions[j][0], oppositions[j][1]
+			if a == c || a == d || b == c || b == d {
+				continue
+			}
+			if g.is(a, c, Square) && g.is(a, d, Square) && g.is(b, c, Square) && g.is(b, d, Square) {
+				result = append(result, Pattern{
+					Type:   GrandCross,
+					Bodies: []position.CelestialBody{a, c, b, d},
+					Tightness: g.meanOrb(pair(a, b), pair(c, d),
+						pair(a, c), pair(a, d), pair(b, c), pair(b, d)),
+				})
+			}
+		}
+	}
+	return result
+}
+
+func findYods(g aspectGraph, bodies []position.CelestialBody) []Pattern {
+	var result []Pattern
+	for i := 0; i < len(bodies); i++ {
+		for j := i + 1; j < len(bodies); j++ {
+			a, b := bodies[i], bodies[j]
+			if !g.is(a, b, Sextile) {
+				continue
+			}
+			for _, apex := range bodies {
+				if apex == a || apex == b || !g.is(apex, a, Quincunx) || !g.is(apex, b, Quincunx) {
+					continue
+				}
+				result = append(result, Pattern{
+					Type:      Yod,
+					Bodies:    []position.CelestialBody{a, b, apex},
+					Apex:      apex,
+					HasApex:   true,
+					Tightness: g.meanOrb(pair(a, b), pair(apex, a), pair(apex, b)),
+				})
+			}
+		}
+	}
+	return result
+}
+
+func findKites(g aspectGraph, bodies []position.CelestialBody) []Pattern {
+	var result []Pattern
+	for _, gt := range findGrandTrines(g, bodies) {
+		for idx, head := range gt.Bodies {
+			wing1 := gt.Bodies[(idx+1)%3]
+			wing2 := gt.Bodies[(idx+2)%3]
+			for _, tail := range bodies {
+				if gt.Contains(tail) {
+					continue
+				}
+				if g.is(tail, head, Opposition) && g.is(tail, wing1, Sextile) && g.is(tail, wing2, Sextile) {
+					result = append(result, Pattern{
+						Type:    Kite,
+						Bodies:  []position.CelestialBody{head, wing1, tail, wing2},
+						Apex:    head,
+						HasApex: true,
+						Tightness: g.meanOrb(pair(head, wing1), pair(wing1, wing2), pair(head, wing2),
+							pair(tail, head), pair(tail, wing1), pair(tail, wing2)),
+					})
+				}
+			}
+		}
+	}
+	return result
+}
+
+func findMysticRectangles(g aspectGraph, bodies []position.CelestialBody) []Pattern {
+	var result []Pattern
+	oppositions := findOppositions(g, bodies)
+	for i := 0; i < len(oppositions); i++ {
+		for j := i + 1; j < len(oppositions); j++ {
+			a, b := oppositions[i][0], oppositions[i][1]
+			c, d := oppositions[j][0], oppositions[j][1]
+			if a == c || a == d || b == c || b == d {
+				continue
+			}
+			// Either a-c and b-d are the sextiles, or a-d and b-c are
+			if !(g.is(a, c, Sextile) && g.is(b, d, Sextile) && g.is(a, d, Trine) && g.is(b, c, Trine)) {
+				if !(g.is(a, d, Sextile) && g.is(b, c, Sextile) && g.is(a, c, Trine) && g.is(b, d, Trine)) {
+					continue
+				}
+				c, d = d, c
+			}
+			result = append(result, Pattern{
+				Type:   MysticRectangle,
+				Bodies: []position.CelestialBody{a, c, b, d},
+				Tightness: g.meanOrb(pair(a, b), pair(c, d),
+					pair(a, c), pair(b, d), pair(a, d), pair(b, c)),
+			})
+		}
+	}
+	return result
+}
+
+func findOppositions(g aspectGraph, bodies []position.CelestialBody) [][2]position.CelestialBody {
+	var result [][2]position.CelestialBody
+	for i := 0; i < len(bodies); i++ {
+		for j := i + 1; j < len(bodies); j++ {
+			if g.is(bodies[i], bodies[j], Opposition) {
+				result = append(result, pair(bodies[i], bodies[j]))
+			}
+		}
+	}
+	return result
+}
+
+// coveredBy reports whether all members belong to one of the patterns
+func coveredBy(members []position.CelestialBody, patterns []Pattern) bool {
+	for _, p := range patterns {
+		all := true
+		for _, m := range members {
+			if !p.Contains(m) {
+				all = false
+				break
+			}
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+func findStellia(positions []position.Position, houses HouseCusps) []Pattern {
+	bySign := make(map[ZodiacSign][]position.Position)
+	byHouse := make(map[int][]position.Position)
+	for _, pos := range positions {
+		if !IsPatternBody(pos.Body) {
+			continue
+		}
+		sign := LongitudeToZodiac(pos.EclipticLongitude).Sign
+		bySign[sign] = append(bySign[sign], pos)
+		if houses != nil {
+			h := houses.GetHouse(pos.EclipticLongitude)
+			byHouse[h] = append(byHouse[h], pos)
+		}
+	}
+
+	var result []Pattern
+	for _, sign := range AllSigns() {
+		if members := bySign[sign]; len(members) >= StelliumMinBodies {
+			p := stellium(StelliumSign, members)
+			p.Sign = sign
+			result = append(result, p)
+		}
+	}
+	for h := 1; h <= 12; h++ {
+		if members := byHouse[h]; len(members) >= StelliumMinBodies {
+			p := stellium(StelliumHouse, members)
+			p.House = h
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// stellium builds a stellium pattern whose tightness is the smallest arc holding all members
+func stellium(t PatternType, members []position.Position) Pattern {
+	lons := make([]float64, len(members))
+	bodies := make([]position.CelestialBody, len(members))
+	for i, m := range members {
+		lons[i] = m.EclipticLongitude
+		bodies[i] = m.Body
+	}
+	sort.Float64s(lons)
+
+	// The spanned arc is 360 minus the largest gap between consecutive bodies
+	largestGap := lons[0] + 360 - lons[len(lons)-1]
+	for i := 1; i < len(lons); i++ {
+		largestGap = math.Max(largestGap, lons[i]-lons[i-1])
+	}
+
+	return Pattern{
+		Type:      t,
+		Bodies:    bodies,
+		Tightness: 360 - largestGap,
+	}
+}
@@ -0,0 +1,215 @@
+package horoscope
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/fixedstar"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// DefaultStarOrb is the default orb (degrees) for fixed star contacts
+const DefaultStarOrb = 1.0
+
+// ParanMaxMagnitude is the faintest magnitude considered for parans
+const ParanMaxMagnitude = 2.0
+
+// Angle represents one of the four chart angles
+type Angle int
+
+// Chart angles.
+const (
+	Ascendant Angle = iota
+	Midheaven
+	Descendant
+	ImumCoeli
+)
+
+// String returns the name of the angle
+func (a Angle) String() string {
+	return angleNames[a]
+}
+
+// Symbol returns the abbreviation of the angle
+func (a Angle) Symbol() string {
+	return angleSymbols[a]
+}
+
+var angleNames = map[Angle]string{
+	Ascendant:  "Ascendant",
+	Midheaven:  "Midheaven",
+	Descendant: "Descendant",
+	ImumCoeli:  "Imum Coeli",
+}
+
+var angleSymbols = map[Angle]string{
+	Ascendant:  "AC",
+	Midheaven:  "MC",
+	Descendant: "DC",
+	ImumCoeli:  "IC",
+}
+
+// AngleLongitude returns the ecliptic longitude of a chart angle
+func (c *Chart) AngleLongitude(a Angle) float64 {
+	switch a {
+	case Midheaven:
+		return c.Houses.GetMC()
+	case Descendant:
+		return position.NormalizeAngle(c.Houses.GetAscendant() + 180)
+	case ImumCoeli:
+		return position.NormalizeAngle(c.Houses.GetMC() + 180)
+	}
+	return c.Houses.GetAscendant()
+}
+
+// HorizonEvent is a crossing of the horizon or meridian in diurnal motion
+type HorizonEvent int
+
+// Horizon events.
+const (
+	Rising HorizonEvent = iota
+	Culminating
+	Setting
+	AntiCulminating
+)
+
+// String returns the name of the event
+func (e HorizonEvent) String() string {
+	return horizonEventNames[e]
+}
+
+var horizonEventNames = map[HorizonEvent]string{
+	Rising:          "Rising",
+	Culminating:     "Culminating",
+	Setting:         "Setting",
+	AntiCulminating: "Anti-culminating",
+}
+
+// StarContactType distinguishes zodiacal conjunctions from parans
+type StarContactType int
+
+// Fixed star contact types.
+const (
+	StarConjunction StarContactType = iota // Same ecliptic longitude
+	StarParan                              // Simultaneously on an angle on the birth day
+)
+
+// String returns the name of the contact type
+func (t StarContactType) String() string {
+	if t == StarParan {
+		return "Paran"
+	}
+	return "Conjunction"
+}
+
+// StarContact links a fixed star to a natal planet or angle
+type StarContact struct {
+	Star      fixedstar.Star
+	Longitude float64 // Star ecliptic longitude at the chart date
+	Type      StarContactType
+	Body      position.CelestialBody
+	Angle     Angle
+	OnAngle   bool         // True if the contact is with Angle rather than Body
+	StarEvent HorizonEvent // Parans only
+	BodyEvent HorizonEvent // Parans only
+	Orb       float64      // Degrees of longitude, or of RAMC for parans
+}
+
+// Point returns the name of the natal point in contact with the star
+func (s StarContact) Point() string {
+	if s.OnAngle {
+		return s.Angle.String()
+	}
+	return s.Body.String()
+}
+
+// CalculateStarContacts finds catalogue stars conjunct natal planets or angles,
+// and bright stars in paran with the planets (Sun to Pluto) at the chart latitude
+func CalculateStarContacts(c *Chart, orb float64) []StarContact {
+	var contacts []StarContact
+
+	for _, star := range fixedstar.Catalogue() {
+		lon, _ := star.EclipticAt(c.DateTime)
+		ra, dec := star.EquatorialAt(c.DateTime)
+
+		for _, pos := range c.Positions {
+			if pos.Body >= position.Vertex && !pos.Body.IsRegistered() {
+				continue
+			}
+			if d := math.Abs(position.NormalizeMotion(lon - pos.EclipticLongitude)); d <= orb {
+				contacts = append(contacts, StarContact{
+					Star: star, Longitude: lon, Type: StarConjunction, Body: pos.Body, Orb: d,
+				})
+			}
+		}
+
+		if c.Houses != nil {
+			for a := Ascendant; a <= ImumCoeli; a++ {
+				if d := math.Abs(position.NormalizeMotion(lon - c.AngleLongitude(a))); d <= orb {
+					contacts = append(contacts, StarContact{
+						Star: star, Longitude: lon, Type: StarConjunction, Angle: a, OnAngle: true, Orb: d,
+					})
+				}
+			}
+		}
+
+		if star.Magnitude <= ParanMaxMagnitude {
+			contacts = append(contacts, parans(c, star, lon, ra, dec, orb)...)
+		}
+	}
+
+	sort.SliceStable(contacts, func(i, j int) bool {
+		if contacts[i].Type != contacts[j].Type {
+			return contacts[i].Type < contacts[j].Type
+		}
+		return contacts[i].Orb < contacts[j].Orb
+	})
+	return contacts
+}
+
+// parans finds the planets crossing an angle at the same moment as the star
+// on the birth day, comparing the sidereal times (RAMC) of both events
+func parans(c *Chart, star fixedstar.Star, lon, ra, dec, orb float64) []StarContact {
+	starEvents := horizonEvents(ra, dec, c.Latitude)
+
+	var contacts []StarContact
+	for _, pos := range c.Positions {
+		if !IsPatternBody(pos.Body) {
+			continue
+		}
+		bodyEvents := horizonEvents(pos.RightAscension, pos.Declination, c.Latitude)
+
+		for se, starRAMC := range starEvents {
+			for be, bodyRAMC := range bodyEvents {
+				// Meridian pairs repeat half a day later; keep one of each
+				if se == AntiCulminating && (be == Culminating || be == AntiCulminating) {
+					continue
+				}
+				if d := math.Abs(position.NormalizeMotion(starRAMC - bodyRAMC)); d <= orb {
+					contacts = append(contacts, StarContact{
+						Star: star, Longitude: lon, Type: StarParan, Body: pos.Body,
+						StarEvent: se, BodyEvent: be, Orb: d,
+					})
+				}
+			}
+		}
+	}
+	return contacts
+}
+
+// horizonEvents returns the RAMC (degrees) at which an object rises, culminates,
+// sets and anti-culminates. Circumpolar and never-rising objects only cross the meridian.
+func horizonEvents(ra, dec, latitude float64) map[HorizonEvent]float64 {
+	events := map[HorizonEvent]float64{
+		Culminating:     ra,
+		AntiCulminating: position.NormalizeAngle(ra + 180),
+	}
+
+	cosH := -math.Tan(position.DegreesToRadians(latitude)) * math.Tan(position.DegreesToRadians(dec))
+	if cosH >= -1 && cosH <= 1 {
+		h := position.RadiansToDegrees(math.Acos(cosH))
+		events[Rising] = position.NormalizeAngle(ra - h)
+		events[Setting] = position.NormalizeAngle(ra + h)
+	}
+	return events
+}
@@ -0,0 +1,295 @@
+package horoscope
+
+import (
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Profection is the sign activated for a year or month of life, counted
+// in Whole Sign houses from the Ascendant
+type Profection struct {
+	Age   int // Completed years at the start of the period
+	Start time.Time
+	End   time.Time
+	Sign  ZodiacSign
+	House int                    // Whole Sign house of the profected sign
+	Lord  position.CelestialBody // Traditional ruler of the profected sign
+}
+
+// AnnualProfection returns the profected year running at t, which advances
+// one sign on each birthday
+func (c *Chart) AnnualProfection(t time.Time) (Profection, bool) {
+	if c.Houses == nil || t.Before(c.DateTime) {
+		return Profection{}, false
+	}
+	age := t.Year() - c.DateTime.Year()
+	if c.DateTime.AddDate(age, 0, 0).After(t) {
+		age--
+	}
+	start := c.DateTime.AddDate(age, 0, 0)
+	return c.profection(age, age, start, c.DateTime.AddDate(age+1, 0, 0)), true
+}
+
+// MonthlyProfection returns the profected month running at t. Each year of
+// life is divided into twelve equal months starting from the year's sign.
+func (c *Chart) MonthlyProfection(t time.Time) (Profection, bool) {
+	year, ok := c.AnnualProfection(t)
+	if !ok {
+		return Profection{}, false
+	}
+	month := year.End.Sub(year.Start) / 12
+	n := int(t.Sub(year.Start) / month)
+	n = min(n, 11)
+	start := year.Start.Add(time.Duration(n) * month)
+	return c.profection(year.Age, year.Age+n, start, start.Add(month)), true
+}
+
+func (c *Chart) profection(age, steps int, start, end time.Time) Profection {
+	ascSign := LongitudeToZodiac(c.Houses.GetAscendant()).Sign
+	sign := ZodiacSign((int(ascSign) + steps) % 12)
+	return Profection{
+		Age:   age,
+		Start: start,
+		End:   end,
+		Sign:  sign,
+		House: steps%12 + 1,
+		Lord:  sign.Ruler(),
+	}
+}
+
+// FirdariaPeriod is a major or minor period of the firdaria
+type FirdariaPeriod struct {
+	Lord    position.CelestialBody
+	SubLord position.CelestialBody // Same as Lord for major periods
+	Major   bool
+	Start   time.Time
+	End     time.Time
+}
+
+type firdar struct {
+	lord  position.CelestialBody
+	years int
+}
+
+// Firdaria sequences (75 years). Day charts start with the Sun, night
+// charts with the Moon; the nodes close both sequences.
+var (
+	dayFirdaria = []firdar{
+		{position.Sun, 10}, {position.Venus, 8}, {position.Mercury, 13}, {position.Moon, 9},
+		{position.Saturn, 11}, {position.Jupiter, 12}, {position.Mars, 7},
+		{position.NorthNode, 3}, {position.SouthNode, 2},
+	}
+	nightFirdaria = []firdar{
+		{position.Moon, 9}, {position.Saturn, 11}, {position.Jupiter, 12}, {position.Mars, 7},
+		{position.Sun, 10}, {position.Venus, 8}, {position.Mercury, 13},
+		{position.NorthNode, 3}, {position.SouthNode, 2},
+	}
+)
+
+// chaldeanOrder is the descending order of the planets that the minor
+// periods of the firdaria follow
+var chaldeanOrder = []position.CelestialBody{
+	position.Saturn, position.Jupiter, position.Mars, position.Sun,
+	position.Venus, position.Mercury, position.Moon,
+}
+
+// Firdaria returns the major periods of life in order
+func (c *Chart) Firdaria() []FirdariaPeriod {
+	sequence := nightFirdaria
+	if c.IsDayChart() {
+		sequence = dayFirdaria
+	}
+
+	periods := make([]FirdariaPeriod, 0, len(sequence))
+	start := c.DateTime
+	for _, f := range sequence {
+		end := start.AddDate(f.years, 0, 0)
+		periods = append(periods, FirdariaPeriod{Lord: f.lord, SubLord: f.lord, Major: true, Start: start, End: end})
+		start = end
+	}
+	return periods
+}
+
+// FirdariaSubperiods divides a planetary major period into seven equal
+// minor periods, starting with its own lord and following the Chaldean
+// order. The nodes have no minor periods.
+func FirdariaSubperiods(major FirdariaPeriod) []FirdariaPeriod {
+	first := -1
+	for i, body := range chaldeanOrder {
+		if body == major.Lord {
+			first = i
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+
+	length := major.End.Sub(major.Start) / 7
+	periods := make([]FirdariaPeriod, 7)
+	for i := range periods {
+		start := major.Start.Add(time.Duration(i) * length)
+		periods[i] = FirdariaPeriod{
+			Lord:    major.Lord,
+			SubLord: chaldeanOrder[(first+i)%7],
+			Start:   start,
+			End:     start.Add(length),
+		}
+	}
+	return periods
+}
+
+// FirdariaAt returns the major and minor periods running at t; the minor
+// period equals the major one during the nodes
+func (c *Chart) FirdariaAt(t time.Time) (FirdariaPeriod, FirdariaPeriod, bool) {
+	for _, major := range c.Firdaria() {
+		if t.Before(major.Start) || !t.Before(major.End) {
+			continue
+		}
+		for _, minor := range FirdariaSubperiods(major) {
+			if !t.Before(minor.Start) && t.Before(minor.End) {
+				return major, minor, true
+			}
+		}
+		return major, major, true
+	}
+	return FirdariaPeriod{}, FirdariaPeriod{}, false
+}
+
+// ReleasingPeriod is a period of zodiacal releasing
+type ReleasingPeriod struct {
+	Level         int // 1 for the general periods, 2 for their subperiods, ...
+	Sign          ZodiacSign
+	Start         time.Time
+	End           time.Time
+	Peak          bool // Sign angular to the Lot of Fortune
+	LoosingOfBond bool // Subperiod that jumps to the opposite sign
+}
+
+// releasingYears are the minor years of each sign's ruler, except that
+// Capricorn and Aquarius differ (27 and 30 years)
+var releasingYears = map[ZodiacSign]int{
+	Aries: 15, Taurus: 8, Gemini: 20, Cancer: 25, Leo: 19, Virgo: 20,
+	Libra: 8, Scorpio: 15, Sagittarius: 12, Capricorn: 27, Aquarius: 30, Pisces: 12,
+}
+
+// releasingYearDays is the length of a year in zodiacal releasing; each
+// level divides it by twelve
+const releasingYearDays = 360.0
+
+// ReleasingSpan is how long after birth general periods are listed
+const ReleasingSpan = 100
+
+// ZodiacalReleasing returns the general (level 1) periods released from a
+// lot (PartOfFortune or PartOfSpirit) over the first ReleasingSpan years
+func (c *Chart) ZodiacalReleasing(lot position.CelestialBody) []ReleasingPeriod {
+	pos := c.GetPosition(lot)
+	fortune := c.GetPosition(position.PartOfFortune)
+	if pos == nil || fortune == nil {
+		return nil
+	}
+
+	parent := ReleasingPeriod{
+		Level: 0,
+		Sign:  LongitudeToZodiac(pos.EclipticLongitude).Sign,
+		Start: c.DateTime,
+		End:   c.DateTime.AddDate(ReleasingSpan, 0, 0),
+	}
+	return releasingPeriods(parent, LongitudeToZodiac(fortune.EclipticLongitude).Sign)
+}
+
+// ReleasingSubperiods returns the periods of the next level within a period
+func (c *Chart) ReleasingSubperiods(p ReleasingPeriod) []ReleasingPeriod {
+	fortune := c.GetPosition(position.PartOfFortune)
+	if fortune == nil {
+		return nil
+	}
+	return releasingPeriods(p, LongitudeToZodiac(fortune.EclipticLongitude).Sign)
+}
+
+// releasingPeriods fills a parent period with consecutive periods of the
+// next level, starting from the parent's sign. After a full round of
+// twelve signs the sequence looses the bond and jumps to the opposite sign.
+func releasingPeriods(parent ReleasingPeriod, fortune ZodiacSign) []ReleasingPeriod {
+	level := parent.Level + 1
+	unit := releasingYearDays
+	for range level - 1 {
+		unit /= 12
+	}
+
+	var periods []ReleasingPeriod
+	sign := parent.Sign
+	start := parent.Start
+	loosed := false
+	for count := 0; start.Before(parent.End); count++ {
+		loosing := false
+		if count == 12 && level > 1 && !loosed {
+			sign, loosing, loosed = sign.Opposite(), true, true
+		}
+		days := float64(releasingYears[sign]) * unit
+		end := start.Add(time.Duration(days * 24 * float64(time.Hour)))
+		if end.After(parent.End) {
+			end = parent.End
+		}
+		periods = append(periods, ReleasingPeriod{
+			Level:         level,
+			Sign:          sign,
+			Start:         start,
+			End:           end,
+			Peak:          (int(sign)-int(fortune)+12)%3 == 0,
+			LoosingOfBond: loosing,
+		})
+		start = end
+		sign = ZodiacSign((int(sign) + 1) % 12)
+	}
+	return periods
+}
+
+// ReleasingAt returns the running periods released from a lot at t, from
+// level 1 down to the given depth
+func (c *Chart) ReleasingAt(lot position.CelestialBody, t time.Time, depth int) []ReleasingPeriod {
+	var result []ReleasingPeriod
+	periods := c.ZodiacalReleasing(lot)
+	for level := 1; level <= depth; level++ {
+		found := false
+		for _, p := range periods {
+			if !t.Before(p.Start) && t.Before(p.End) {
+				result = append(result, p)
+				periods = c.ReleasingSubperiods(p)
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return result
+}
+
+// TimeLords gathers the periods running at a date
+type TimeLords struct {
+	Date           time.Time
+	Year           Profection
+	Month          Profection
+	HasProfections bool
+	Firdaria       FirdariaPeriod
+	FirdariaMinor  FirdariaPeriod
+	HasFirdaria    bool
+	Fortune        []ReleasingPeriod // Levels 1 and 2
+	Spirit         []ReleasingPeriod // Levels 1 and 2
+}
+
+// TimeLordsAt returns the profections, firdaria and zodiacal releasing
+// periods running at t
+func (c *Chart) TimeLordsAt(t time.Time) TimeLords {
+	tl := TimeLords{Date: t}
+	tl.Year, tl.HasProfections = c.AnnualProfection(t)
+	if tl.HasProfections {
+		tl.Month, _ = c.MonthlyProfection(t)
+	}
+	tl.Firdaria, tl.FirdariaMinor, tl.HasFirdaria = c.FirdariaAt(t)
+	tl.Fortune = c.ReleasingAt(position.PartOfFortune, t, 2)
+	tl.Spirit = c.ReleasingAt(position.PartOfSpirit, t, 2)
+	return tl
+}
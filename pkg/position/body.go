@@ -26,15 +26,30 @@ const (
 	Pallas
 	Juno
 	Vesta
+	Lilith
+	Vertex
+	AntiVertex
+	EastPoint
+	PartOfFortune
+	PartOfSpirit
+	PartOfEros
+	PartOfNecessity
+	Earth // Heliocentric charts only
 )
 
 // String returns the name of the celestial body
 func (b CelestialBody) String() string {
+	if r, ok := registeredInfo[b]; ok {
+		return r.name
+	}
 	return bodyNames[b]
 }
 
 // Symbol returns the astrological symbol for the body
 func (b CelestialBody) Symbol() string {
+	if r, ok := registeredInfo[b]; ok {
+		return r.symbol
+	}
 	return bodySymbols[b]
 }
 
@@ -56,6 +71,16 @@ var bodyNames = map[CelestialBody]string{
 	Pallas:    "Pallas",
 	Juno:      "Juno",
 	Vesta:     "Vesta",
+
+	Lilith:          "Lilith",
+	Vertex:          "Vertex",
+	AntiVertex:      "Anti-Vertex",
+	EastPoint:       "East Point",
+	PartOfFortune:   "Part of Fortune",
+	PartOfSpirit:    "Part of Spirit",
+	PartOfEros:      "Part of Eros",
+	PartOfNecessity: "Part of Necessity",
+	Earth:           "Earth",
 }
 
 var bodySymbols = map[CelestialBody]string{
@@ -76,14 +101,35 @@ var bodySymbols = map[CelestialBody]string{
 	Pallas:    "⚴",
 	Juno:      "⚵",
 	Vesta:     "⚶",
+
+	Lilith:          "⚸",
+	Vertex:          "Vx",
+	AntiVertex:      "Av",
+	EastPoint:       "EP",
+	PartOfFortune:   "⊗",
+	PartOfSpirit:    "Sp",
+	PartOfEros:      "Er",
+	PartOfNecessity: "Ne",
+	Earth:           "⊕",
 }
 
-// AllBodies returns all celestial bodies in order
+// AllBodies returns all celestial bodies in order, followed by the
+// registered bodies
 func AllBodies() []CelestialBody {
-	return []CelestialBody{
+	bodies := []CelestialBody{
 		Sun, Moon, Mercury, Venus, Mars, Jupiter, Saturn,
 		Uranus, Neptune, Pluto, NorthNode, SouthNode,
-		Chiron, Ceres, Pallas, Juno, Vesta,
+		Chiron, Ceres, Pallas, Juno, Vesta, Lilith,
+	}
+	return append(bodies, registered...)
+}
+
+// CalculatedPoints returns the location-dependent points and Arabic Parts.
+// They are not part of AllBodies because they need the chart location.
+func CalculatedPoints() []CelestialBody {
+	return []CelestialBody{
+		Vertex, AntiVertex, EastPoint,
+		PartOfFortune, PartOfSpirit, PartOfEros, PartOfNecessity,
 	}
 }
 
@@ -97,8 +143,13 @@ type Position struct {
 	Body              CelestialBody
 	EclipticLongitude float64 // degrees 0-360
 	EclipticLatitude  float64 // degrees
-	Distance          float64 // AU (or Earth radii for Moon)
+	RightAscension    float64 // degrees 0-360
+	Declination       float64 // degrees, north positive
+	Distance          float64 // AU from the Earth's centre, or the Sun's in heliocentric charts (0 for points)
 	Retrograde        bool    // true if apparent retrograde motion
+	Speed             float64 // degrees/day in longitude
+	LatitudeSpeed     float64 // degrees/day in latitude
+	Stationary        bool    // true near a station (direction change)
 }
 
 // CanBeRetrograde reports whether this body can exhibit retrograde motion.
@@ -107,7 +158,18 @@ func (b CelestialBody) CanBeRetrograde() bool {
 	case Mercury, Venus, Mars, Jupiter, Saturn, Uranus, Neptune, Pluto, Chiron, Ceres, Pallas, Juno, Vesta:
 		return true
 	}
-	return false
+	return b.IsRegistered()
+}
+
+// IsPoint reports whether this is a calculated point rather than a physical body.
+func (b CelestialBody) IsPoint() bool {
+	switch b {
+	case NorthNode, SouthNode, Lilith:
+		return true
+	case Earth:
+		return false
+	}
+	return b >= Vertex && !b.IsRegistered()
 }
 
 // IsMainPlanet reports whether this is a traditional planet (Sun through Saturn).
@@ -1,6 +1,6 @@
 package position
 
-// OrbitalElements contains the Keplerian orbital elements at J2000.0 epoch
+// OrbitalElements contains the Keplerian orbital elements at the ElementsEpoch
 // and their rates of change per century
 // Source: Paul Schlyter (stjarnhimlen.se) and JPL approximate positions
 type OrbitalElements struct {
@@ -30,7 +30,7 @@ type OrbitalElements struct {
 	MRate float64
 }
 
-// AtDay returns computed orbital elements for a given day number from J2000
+// AtDay returns computed orbital elements for a given day number (see DayNumber)
 func (o OrbitalElements) AtDay(d float64) ComputedElements {
 	return ComputedElements{
 		N: NormalizeAngle(o.N + o.NRate*d),
@@ -53,7 +53,7 @@ type ComputedElements struct {
 }
 
 // PlanetElements contains orbital elements for all celestial bodies
-// Elements are for J2000.0 epoch with rates per day
+// Elements are for the ElementsEpoch with rates per day
 // Source: Paul Schlyter's "Computing planetary positions"
 var PlanetElements = map[CelestialBody]OrbitalElements{
 	// Sun (actually Earth's orbit seen from geocentric perspective)
@@ -141,10 +141,10 @@ var PlanetElements = map[CelestialBody]OrbitalElements{
 	Pluto: {
 		N: 110.30347, NRate: 0.0,
 		I: 17.14175, IRate: 0.0,
-		W: 224.06676, WRate: 0.0,
+		W: 113.76329, WRate: 0.0,
 		A: 39.48168677, ARate: 0.0,
 		E: 0.24880766, ERate: 0.0,
-		M: 238.92881, MRate: 0.003971354,
+		M: 14.86205, MRate: 0.003971354,
 	},
 	// Chiron
 	Chiron: {
@@ -195,3 +195,6 @@ var PlanetElements = map[CelestialBody]OrbitalElements{
 
 // Obliquity of the ecliptic at J2000.0 (degrees)
 const Obliquity = 23.4393
+
+// EarthRadiusAU is the Earth's equatorial radius in astronomical units
+const EarthRadiusAU = 6378.14 / 149597870.7
@@ -0,0 +1,42 @@
+package position
+
+import "math"
+
+// EclipticToEquatorial converts ecliptic longitude and latitude to
+// right ascension and declination, all in degrees
+func EclipticToEquatorial(longitude, latitude float64) (float64, float64) {
+	lon := DegreesToRadians(longitude)
+	lat := DegreesToRadians(latitude)
+	obl := DegreesToRadians(Obliquity)
+
+	ra := math.Atan2(math.Sin(lon)*math.Cos(obl)-math.Tan(lat)*math.Sin(obl), math.Cos(lon))
+	dec := math.Asin(math.Sin(lat)*math.Cos(obl) + math.Cos(lat)*math.Sin(obl)*math.Sin(lon))
+
+	return NormalizeAngle(RadiansToDegrees(ra)), RadiansToDegrees(dec)
+}
+
+// WithEquatorial returns the position with its right ascension and
+// declination derived from the ecliptic coordinates
+func (p Position) WithEquatorial() Position {
+	p.RightAscension, p.Declination = EclipticToEquatorial(p.EclipticLongitude, p.EclipticLatitude)
+	return p
+}
+
+// IsOutOfBounds reports whether the declination exceeds the obliquity of the
+// ecliptic, i.e. the body lies beyond the Sun's maximum declination
+func (p Position) IsOutOfBounds() bool {
+	return math.Abs(p.Declination) > Obliquity
+}
+
+// EquatorialToEcliptic converts right ascension and declination to
+// ecliptic longitude and latitude, all in degrees
+func EquatorialToEcliptic(ra, dec float64) (float64, float64) {
+	alpha := DegreesToRadians(ra)
+	delta := DegreesToRadians(dec)
+	obl := DegreesToRadians(Obliquity)
+
+	lon := math.Atan2(math.Sin(alpha)*math.Cos(obl)+math.Tan(delta)*math.Sin(obl), math.Cos(alpha))
+	lat := math.Asin(math.Sin(delta)*math.Cos(obl) - math.Cos(delta)*math.Sin(obl)*math.Sin(alpha))
+
+	return NormalizeAngle(RadiansToDegrees(lon)), RadiansToDegrees(lat)
+}
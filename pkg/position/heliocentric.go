@@ -0,0 +1,60 @@
+package position
+
+import (
+	"math"
+	"time"
+)
+
+// HeliocentricBodies returns the bodies of a heliocentric chart.
+// The Earth takes the Sun's place; the Moon, nodes and calculated points
+// have no heliocentric meaning and are left out. Registered bodies follow.
+func HeliocentricBodies() []CelestialBody {
+	bodies := []CelestialBody{
+		Earth, Mercury, Venus, Mars, Jupiter, Saturn,
+		Uranus, Neptune, Pluto,
+		Chiron, Ceres, Pallas, Juno, Vesta,
+	}
+	return append(bodies, registered...)
+}
+
+// CalculateHeliocentric computes heliocentric positions for all
+// heliocentric bodies at a given time, including their daily motion
+func CalculateHeliocentric(t time.Time) []Position {
+	d := DayNumber(t)
+	bodies := HeliocentricBodies()
+	positions := make([]Position, len(bodies))
+	for i, body := range bodies {
+		positions[i] = WithMotion(body, d, func(d float64) Position {
+			return CalculateHeliocentricAtDay(body, d)
+		})
+		positions[i].Retrograde = false
+	}
+	return positions
+}
+
+// CalculateHeliocentricAtDay computes a body's position as seen from the Sun.
+// Heliocentric motion is always direct, so Retrograde is never set.
+func CalculateHeliocentricAtDay(body CelestialBody, d float64) Position {
+	if body == Earth {
+		sun := calculateSun(d)
+		return Position{
+			Body:              Earth,
+			EclipticLongitude: NormalizeAngle(sun.EclipticLongitude + 180),
+			EclipticLatitude:  0,
+			Distance:          sun.Distance,
+		}.WithEquatorial()
+	}
+
+	elem, ok := PlanetElements[body]
+	if !ok || body == Sun || body == Moon {
+		return Position{Body: body}
+	}
+
+	xh, yh, zh := heliocentricVector(elem.AtDay(d))
+	return Position{
+		Body:              body,
+		EclipticLongitude: NormalizeAngle(RadiansToDegrees(math.Atan2(yh, xh))),
+		EclipticLatitude:  RadiansToDegrees(math.Atan2(zh, math.Sqrt(xh*xh+yh*yh))),
+		Distance:          math.Sqrt(xh*xh + yh*yh + zh*zh),
+	}.WithEquatorial()
+}
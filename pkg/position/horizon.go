@@ -0,0 +1,178 @@
+package position
+
+import (
+	"math"
+	"time"
+)
+
+// Standard altitudes (degrees) of the upper limb at rising and setting,
+// including atmospheric refraction and, for the Sun and Moon, semidiameter
+const (
+	starRiseAltitude = -0.5667
+	sunRiseAltitude  = -0.8333
+)
+
+// solarParallax is the equatorial horizontal parallax at 1 AU (degrees)
+const solarParallax = 8.794 / 3600
+
+// riseSetStep is the sampling interval used to bracket horizon crossings
+const riseSetStep = 10 * time.Minute
+
+// SkyPosition describes a body as seen by an observer at a location
+type SkyPosition struct {
+	Body         CelestialBody
+	Altitude     float64 // degrees above the horizon (topocentric, no refraction)
+	Azimuth      float64 // degrees from north through east
+	AboveHorizon bool
+	Rise         time.Time
+	Transit      time.Time
+	Set          time.Time
+	HasRise      bool // false when the body does not rise on that day
+	HasSet       bool // false when the body does not set on that day
+}
+
+// Topocentric corrects a geocentric position for the observer's parallax.
+// Only the Moon is noticeably affected.
+func Topocentric(p Position, latitude, longitude float64, t time.Time) Position {
+	if p.Distance == 0 {
+		return p
+	}
+
+	lst := LocalSiderealTime(JulianDay(t), longitude)
+	sinPi := math.Sin(DegreesToRadians(solarParallax)) / p.Distance
+
+	// Observer's geocentric coordinates at sea level (Meeus, chapter 11)
+	latRad := DegreesToRadians(latitude)
+	u := math.Atan(0.99664719 * math.Tan(latRad))
+	rhoSin := 0.99664719 * math.Sin(u)
+	rhoCos := math.Cos(u)
+
+	h := DegreesToRadians(lst - p.RightAscension)
+	dec := DegreesToRadians(p.Declination)
+
+	// Parallax in right ascension and declination (Meeus, chapter 40)
+	den := math.Cos(dec) - rhoCos*sinPi*math.Cos(h)
+	dRA := math.Atan2(-rhoCos*sinPi*math.Sin(h), den)
+	topoDec := math.Atan2((math.Sin(dec)-rhoSin*sinPi)*math.Cos(dRA), den)
+
+	p.RightAscension = NormalizeAngle(p.RightAscension + RadiansToDegrees(dRA))
+	p.Declination = RadiansToDegrees(topoDec)
+	p.EclipticLongitude, p.EclipticLatitude = EquatorialToEcliptic(p.RightAscension, p.Declination)
+	return p
+}
+
+// HorizontalCoordinates converts equatorial coordinates to altitude and
+// azimuth (from north through east) for a latitude and local sidereal time
+func HorizontalCoordinates(ra, dec, latitude, lst float64) (float64, float64) {
+	h := DegreesToRadians(lst - ra)
+	d := DegreesToRadians(dec)
+	lat := DegreesToRadians(latitude)
+
+	alt := math.Asin(math.Sin(lat)*math.Sin(d) + math.Cos(lat)*math.Cos(d)*math.Cos(h))
+	az := math.Atan2(math.Sin(h), math.Cos(h)*math.Sin(lat)-math.Tan(d)*math.Cos(lat))
+
+	// Meeus measures azimuth from the south; turn it to north-based
+	return RadiansToDegrees(alt), NormalizeAngle(RadiansToDegrees(az) + 180)
+}
+
+// Observe computes the altitude and azimuth of a body at a time, with its
+// rise, transit and set times on that calendar day in the time's location
+func Observe(body CelestialBody, latitude, longitude float64, t time.Time) SkyPosition {
+	sky := SkyPosition{Body: body}
+	sky.Altitude, sky.Azimuth = altAz(body, latitude, longitude, t)
+	sky.AboveHorizon = sky.Altitude > 0
+
+	dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	sky.Rise, sky.HasRise, sky.Set, sky.HasSet = riseSet(body, latitude, longitude, dayStart)
+	sky.Transit = transit(body, longitude, dayStart)
+	return sky
+}
+
+// ObserveAll observes every physical body (calculated points excluded)
+func ObserveAll(latitude, longitude float64, t time.Time) []SkyPosition {
+	var result []SkyPosition
+	for _, body := range AllBodies() {
+		if body.IsPoint() {
+			continue
+		}
+		result = append(result, Observe(body, latitude, longitude, t))
+	}
+	return result
+}
+
+// altAz returns the topocentric altitude and azimuth of a body
+func altAz(body CelestialBody, latitude, longitude float64, t time.Time) (float64, float64) {
+	p := Topocentric(Calculate(body, t), latitude, longitude, t)
+	lst := LocalSiderealTime(JulianDay(t), longitude)
+	return HorizontalCoordinates(p.RightAscension, p.Declination, latitude, lst)
+}
+
+// riseAltitude returns the altitude at which a body is considered to rise or set
+func riseAltitude(body CelestialBody) float64 {
+	if body == Sun || body == Moon {
+		return sunRiseAltitude
+	}
+	return starRiseAltitude
+}
+
+// riseSet finds the first rising and setting within 24 hours of dayStart
+// by sampling the altitude and refining each crossing by bisection
+func riseSet(body CelestialBody, latitude, longitude float64, dayStart time.Time) (time.Time, bool, time.Time, bool) {
+	h0 := riseAltitude(body)
+	above := func(t time.Time) float64 {
+		alt, _ := altAz(body, latitude, longitude, t)
+		return alt - h0
+	}
+
+	var rise, set time.Time
+	hasRise, hasSet := false, false
+
+	prevT := dayStart
+	prev := above(prevT)
+	for t := dayStart.Add(riseSetStep); !t.After(dayStart.Add(24 * time.Hour)); t = t.Add(riseSetStep) {
+		cur := above(t)
+		if prev < 0 && cur >= 0 && !hasRise {
+			rise, hasRise = bisect(above, prevT, t), true
+		}
+		if prev >= 0 && cur < 0 && !hasSet {
+			set, hasSet = bisect(above, prevT, t), true
+		}
+		prevT, prev = t, cur
+	}
+	return rise, hasRise, set, hasSet
+}
+
+// transit finds the upper meridian passage within 24 hours of dayStart,
+// where the hour angle crosses zero going from east to west
+func transit(body CelestialBody, longitude float64, dayStart time.Time) time.Time {
+	hourAngle := func(t time.Time) float64 {
+		p := Calculate(body, t)
+		return NormalizeMotion(LocalSiderealTime(JulianDay(t), longitude) - p.RightAscension)
+	}
+
+	prevT := dayStart
+	prev := hourAngle(prevT)
+	for t := dayStart.Add(riseSetStep); !t.After(dayStart.Add(24 * time.Hour)); t = t.Add(riseSetStep) {
+		cur := hourAngle(t)
+		if prev < 0 && cur >= 0 {
+			return bisect(hourAngle, prevT, t)
+		}
+		prevT, prev = t, cur
+	}
+	return dayStart
+}
+
+// bisect narrows a sign change of f between a and b down to one second
+func bisect(f func(time.Time) float64, a, b time.Time) time.Time {
+	fa := f(a)
+	for b.Sub(a) > time.Second {
+		mid := a.Add(b.Sub(a) / 2)
+		fm := f(mid)
+		if (fa < 0) == (fm < 0) {
+			a, fa = mid, fm
+		} else {
+			b = mid
+		}
+	}
+	return a
+}
@@ -0,0 +1,97 @@
+package position
+
+import "math"
+
+// LilithModel selects how the Black Moon Lilith (lunar apogee) is computed
+type LilithModel int
+
+// Black Moon Lilith models.
+const (
+	MeanLilith       LilithModel = iota // Apogee of the mean lunar orbit
+	OsculatingLilith                    // Apogee of the instantaneous (osculating) lunar orbit
+)
+
+// String returns the name of the Lilith model
+func (l LilithModel) String() string {
+	if l == OsculatingLilith {
+		return "osculating"
+	}
+	return "mean"
+}
+
+// earthMoonGM is G*(M_earth+M_moon) in Earth radii^3/day^2
+const earthMoonGM = 11467.0 * 1.0123
+
+// osculatingStep is the time step (days) used to differentiate the Moon's position
+const osculatingStep = 0.01
+
+// calculateLilith computes the Black Moon Lilith with the given model
+func calculateLilith(d float64, model LilithModel) Position {
+	lon := meanLilithLongitude(d)
+	lat := 0.0
+	if model == OsculatingLilith {
+		lon, lat = osculatingApogee(d)
+	}
+
+	return Position{
+		Body:              Lilith,
+		EclipticLongitude: lon,
+		EclipticLatitude:  lat,
+		Distance:          0,
+	}
+}
+
+// meanLilithLongitude returns the longitude of the mean lunar apogee
+// (mean perigee N + w plus 180 degrees)
+func meanLilithLongitude(d float64) float64 {
+	elem := PlanetElements[Moon].AtDay(d)
+	return NormalizeAngle(elem.N + elem.W + 180)
+}
+
+// osculatingApogee derives the apogee direction from the Moon's position
+// and velocity through the eccentricity vector of the two-body orbit
+func osculatingApogee(d float64) (float64, float64) {
+	r := moonVector(d)
+	before := moonVector(d - osculatingStep)
+	after := moonVector(d + osculatingStep)
+
+	var v [3]float64
+	for i := range v {
+		v[i] = (after[i] - before[i]) / (2 * osculatingStep)
+	}
+
+	h := cross(r, v)
+	vxh := cross(v, h)
+	rLen := math.Sqrt(r[0]*r[0] + r[1]*r[1] + r[2]*r[2])
+
+	// Eccentricity vector points to perigee; the apogee is opposite
+	var apogee [3]float64
+	for i := range apogee {
+		apogee[i] = -(vxh[i]/earthMoonGM - r[i]/rLen)
+	}
+
+	lon := RadiansToDegrees(math.Atan2(apogee[1], apogee[0]))
+	lat := RadiansToDegrees(math.Atan2(apogee[2], math.Sqrt(apogee[0]*apogee[0]+apogee[1]*apogee[1])))
+	return NormalizeAngle(lon), lat
+}
+
+// moonVector returns the Moon's geocentric ecliptic position in Earth radii
+func moonVector(d float64) [3]float64 {
+	moon := calculateMoon(d)
+	lon := DegreesToRadians(moon.EclipticLongitude)
+	lat := DegreesToRadians(moon.EclipticLatitude)
+	r := moon.Distance / EarthRadiusAU
+	return [3]float64{
+		r * math.Cos(lat) * math.Cos(lon),
+		r * math.Cos(lat) * math.Sin(lon),
+		r * math.Sin(lat),
+	}
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
@@ -75,7 +75,7 @@ func calculateMoon(d float64) Position {
 		Body:              Moon,
 		EclipticLongitude: NormalizeAngle(lon),
 		EclipticLatitude:  lat,
-		Distance:          r,
+		Distance:          r * EarthRadiusAU,
 	}
 }
 
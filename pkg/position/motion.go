@@ -0,0 +1,109 @@
+package position
+
+import "math"
+
+// motionDelta is the number of days before/after used to measure daily motion.
+const motionDelta = 0.5
+
+// SpeedClass classifies a body's daily motion against its mean motion
+type SpeedClass int
+
+// Speed classes.
+const (
+	SpeedSlow SpeedClass = iota
+	SpeedFast
+	SpeedStationary
+)
+
+// String returns the name of the speed class
+func (s SpeedClass) String() string {
+	switch s {
+	case SpeedFast:
+		return "fast"
+	case SpeedStationary:
+		return "stationary"
+	}
+	return "slow"
+}
+
+// Mean geocentric daily motion (degrees/day) of each moving body
+var meanDailyMotion = map[CelestialBody]float64{
+	Sun:       0.9856,
+	Moon:      13.1764,
+	Mercury:   0.9856,
+	Venus:     0.9856,
+	Mars:      0.5240,
+	Jupiter:   0.0831,
+	Saturn:    0.0335,
+	Uranus:    0.0117,
+	Neptune:   0.0060,
+	Pluto:     0.0040,
+	NorthNode: 0.0530,
+	SouthNode: 0.0530,
+	Chiron:    0.0195,
+	Ceres:     0.2141,
+	Pallas:    0.2136,
+	Juno:      0.2261,
+	Vesta:     0.2716,
+	Lilith:    0.1114,
+	Earth:     0.9856,
+}
+
+// Days either side of a station within which a body counts as stationary
+var stationWindows = map[CelestialBody]float64{
+	Mercury: 1,
+	Venus:   2,
+	Mars:    3,
+	Jupiter: 5,
+	Saturn:  6,
+	Uranus:  7,
+	Neptune: 8,
+	Pluto:   8,
+	Chiron:  7,
+	Ceres:   4,
+	Pallas:  4,
+	Juno:    4,
+	Vesta:   4,
+}
+
+// MeanDailyMotion returns the mean daily motion of a body, if it has one
+func MeanDailyMotion(body CelestialBody) (float64, bool) {
+	m, ok := meanDailyMotion[body]
+	return m, ok
+}
+
+// SpeedClass classifies the position's speed as stationary, or fast or slow
+// compared with the body's mean daily motion
+func (p Position) SpeedClass() SpeedClass {
+	if p.Stationary {
+		return SpeedStationary
+	}
+	if mean, ok := MeanDailyMotion(p.Body); ok && math.Abs(p.Speed) > mean {
+		return SpeedFast
+	}
+	return SpeedSlow
+}
+
+// WithMotion computes a body's position at day d with calc and fills in its
+// speeds, station and retrograde flags from central differences
+func WithMotion(body CelestialBody, d float64, calc func(d float64) Position) Position {
+	p := calc(d)
+	p.Speed, p.LatitudeSpeed = dailyMotion(d, calc)
+	p.Retrograde = body.CanBeRetrograde() && p.Speed < 0
+
+	if window, ok := stationWindows[body]; ok {
+		before, _ := dailyMotion(d-window, calc)
+		after, _ := dailyMotion(d+window, calc)
+		p.Stationary = (before < 0) != (after < 0)
+	}
+	return p
+}
+
+// dailyMotion returns the speed in longitude and latitude (degrees/day) at day d
+func dailyMotion(d float64, calc func(d float64) Position) (float64, float64) {
+	before := calc(d - motionDelta)
+	after := calc(d + motionDelta)
+	lon := NormalizeMotion(after.EclipticLongitude-before.EclipticLongitude) / (2 * motionDelta)
+	lat := (after.EclipticLatitude - before.EclipticLatitude) / (2 * motionDelta)
+	return lon, lat
+}
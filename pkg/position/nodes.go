@@ -2,17 +2,33 @@ package position
 
 import "math"
 
-// calculateNorthNode computes the Mean North Node (Rahu)
-func calculateNorthNode(d float64) Position {
-	// Mean North Node moves retrograde at ~19.35 degrees/year
-	// Using Moon's ascending node
-	elem := PlanetElements[Moon]
-	N := elem.N + elem.NRate*d
-	N = NormalizeAngle(N)
+// NodeModel selects how the lunar nodes are computed
+type NodeModel int
+
+// Lunar node models.
+const (
+	MeanNode NodeModel = iota // Smoothly regressing mean node
+	TrueNode                  // Mean node corrected for the main lunar perturbations
+)
+
+// String returns the name of the node model
+func (n NodeModel) String() string {
+	if n == TrueNode {
+		return "true"
+	}
+	return "mean"
+}
+
+// calculateNorthNode computes the North Node (Rahu) with the given model
+func calculateNorthNode(d float64, model NodeModel) Position {
+	lon := meanNodeLongitude(d)
+	if model == TrueNode {
+		lon = TrueNorthNode(d)
+	}
 
 	return Position{
 		Body:              NorthNode,
-		EclipticLongitude: N,
+		EclipticLongitude: lon,
 		EclipticLatitude:  0,
 		Distance:          0, // Not applicable
 	}
@@ -20,8 +36,8 @@ func calculateNorthNode(d float64) Position {
 
 // calculateSouthNode computes the South Node (Ketu)
 // Always opposite to North Node
-func calculateSouthNode(d float64) Position {
-	northNode := calculateNorthNode(d)
+func calculateSouthNode(d float64, model NodeModel) Position {
+	northNode := calculateNorthNode(d, model)
 
 	return Position{
 		Body:              SouthNode,
@@ -31,19 +47,28 @@ func calculateSouthNode(d float64) Position {
 	}
 }
 
-// TrueNorthNode calculates the True North Node with nutation correction
-func TrueNorthNode(d float64) float64 {
-	meanNode := calculateNorthNode(d).EclipticLongitude
+// meanNodeLongitude returns the Mean North Node longitude.
+// Mean North Node moves retrograde at ~19.35 degrees/year
+func meanNodeLongitude(d float64) float64 {
+	elem := PlanetElements[Moon]
+	return NormalizeAngle(elem.N + elem.NRate*d)
+}
 
-	// Simplified nutation correction
-	// Full calculation would use IAU 2000A model
-	sunElem := PlanetElements[Sun].AtDay(d)
+// TrueNorthNode calculates the True North Node longitude
+// Periodic terms from Meeus, Astronomical Algorithms, chapter 47
+func TrueNorthNode(d float64) float64 {
+	t := (d + ElementsEpoch - J2000) / 36525 // Julian centuries from J2000
 
-	// Longitude of Moon's ascending node
-	omega := DegreesToRadians(125.04 - 1934.136*d/36525)
+	elong := DegreesToRadians(297.8501921 + 445267.1114034*t) // Moon's mean elongation
+	sunM := DegreesToRadians(357.5291092 + 35999.0502909*t)   // Sun's mean anomaly
+	moonM := DegreesToRadians(134.9633964 + 477198.8675055*t) // Moon's mean anomaly
+	argLat := DegreesToRadians(93.2720950 + 483202.0175233*t) // Moon's argument of latitude
 
-	// Simplified nutation in longitude (arcseconds to degrees)
-	deltaPsi := -17.20*math.Sin(omega)/3600 - 1.32*math.Sin(2*DegreesToRadians(sunElem.M+sunElem.W))/3600
+	correction := -1.4979*math.Sin(2*(elong-argLat)) -
+		0.1500*math.Sin(sunM) -
+		0.1226*math.Sin(2*elong) +
+		0.1176*math.Sin(2*argLat) -
+		0.0801*math.Sin(2*(moonM-argLat))
 
-	return NormalizeAngle(meanNode + deltaPsi)
+	return NormalizeAngle(meanNodeLongitude(d) + correction)
 }
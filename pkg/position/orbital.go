@@ -11,15 +11,37 @@ func Calculate(body CelestialBody, t time.Time) Position {
 	return CalculateAtDay(body, d)
 }
 
-// CalculateAtDay computes the position for a day number from J2000
+// Options selects the models used for the lunar nodes and Lilith
+type Options struct {
+	Nodes  NodeModel
+	Lilith LilithModel
+}
+
+// DefaultOptions uses the mean node and the mean Lilith
+var DefaultOptions = Options{Nodes: MeanNode, Lilith: MeanLilith}
+
+// CalculateAtDay computes the position for a day number (see DayNumber)
 func CalculateAtDay(body CelestialBody, d float64) Position {
+	return CalculateAtDayWithOptions(body, d, DefaultOptions)
+}
+
+// CalculateAtDayWithOptions computes the position for a day number (see DayNumber)
+// using the given node and Lilith models
+func CalculateAtDayWithOptions(body CelestialBody, d float64, opts Options) Position {
+	return calculateEcliptic(body, d, opts).WithEquatorial()
+}
+
+// calculateEcliptic computes the ecliptic coordinates of a body
+func calculateEcliptic(body CelestialBody, d float64, opts Options) Position {
 	switch body {
 	case Moon:
 		return calculateMoon(d)
 	case NorthNode:
-		return calculateNorthNode(d)
+		return calculateNorthNode(d, opts.Nodes)
 	case SouthNode:
-		return calculateSouthNode(d)
+		return calculateSouthNode(d, opts.Nodes)
+	case Lilith:
+		return calculateLilith(d, opts.Lilith)
 	case Sun:
 		return calculateSun(d)
 	default:
@@ -61,8 +83,34 @@ func calculatePlanet(body CelestialBody, d float64) Position {
 		return Position{Body: body}
 	}
 
-	ce := elem.AtDay(d)
+	xh, yh, zh := heliocentricVector(elem.AtDay(d))
+
+	// Get Sun's position for geocentric conversion
+	sun := calculateSun(d)
+	sunR := sun.Distance
+	sunLon := DegreesToRadians(sun.EclipticLongitude)
+
+	// Earth's heliocentric position (opposite of Sun's geocentric)
+	xg := xh + sunR*math.Cos(sunLon)
+	yg := yh + sunR*math.Sin(sunLon)
+	zg := zh
 
+	// Convert to ecliptic longitude/latitude
+	lon := RadiansToDegrees(math.Atan2(yg, xg))
+	lat := RadiansToDegrees(math.Atan2(zg, math.Sqrt(xg*xg+yg*yg)))
+	dist := math.Sqrt(xg*xg + yg*yg + zg*zg)
+
+	return Position{
+		Body:              body,
+		EclipticLongitude: NormalizeAngle(lon),
+		EclipticLatitude:  lat,
+		Distance:          dist,
+	}
+}
+
+// heliocentricVector returns the heliocentric ecliptic coordinates (AU)
+// of a body from its orbital elements
+func heliocentricVector(ce ComputedElements) (float64, float64, float64) {
 	// Solve Kepler's equation
 	M := DegreesToRadians(ce.M)
 	E := solveKepler(M, ce.E)
@@ -83,34 +131,7 @@ func calculatePlanet(body CelestialBody, d float64) Position {
 	xh := r * (math.Cos(N)*math.Cos(v+W) - math.Sin(N)*math.Sin(v+W)*math.Cos(I))
 	yh := r * (math.Sin(N)*math.Cos(v+W) + math.Cos(N)*math.Sin(v+W)*math.Cos(I))
 	zh := r * math.Sin(v+W) * math.Sin(I)
-
-	// Get Sun's position for geocentric conversion
-	sunPos := calculateSun(d)
-	sunElem := PlanetElements[Sun].AtDay(d)
-	sunM := DegreesToRadians(sunElem.M)
-	sunE := solveKepler(sunM, sunElem.E)
-	sunXv := sunElem.A * (math.Cos(sunE) - sunElem.E)
-	sunYv := sunElem.A * math.Sqrt(1-sunElem.E*sunElem.E) * math.Sin(sunE)
-	sunV := math.Atan2(sunYv, sunXv)
-	sunR := math.Sqrt(sunXv*sunXv + sunYv*sunYv)
-	sunLon := sunV + DegreesToRadians(sunElem.W)
-
-	// Earth's heliocentric position (opposite of Sun's geocentric)
-	xg := xh + sunR*math.Cos(sunLon)
-	yg := yh + sunR*math.Sin(sunLon)
-	zg := zh
-
-	// Convert to ecliptic longitude/latitude
-	lon := RadiansToDegrees(math.Atan2(yg, xg))
-	lat := RadiansToDegrees(math.Atan2(zg, math.Sqrt(xg*xg+yg*yg)))
-	dist := math.Sqrt(xg*xg + yg*yg + zg*zg)
-
-	return Position{
-		Body:              body,
-		EclipticLongitude: NormalizeAngle(lon),
-		EclipticLatitude:  lat,
-		Distance:          dist + sunPos.Distance,
-	}
+	return xh, yh, zh
 }
 
 // solveKepler solves Kepler's equation M = E - e*sin(E) iteratively
@@ -133,40 +154,40 @@ func solveKepler(meanAnom float64, e float64) float64 {
 
 // CalculateAll computes positions for all celestial bodies at a given time
 func CalculateAll(t time.Time) []Position {
+	return CalculateAllWithOptions(t, DefaultOptions)
+}
+
+// CalculateAllWithOptions computes positions for all celestial bodies
+// using the given node and Lilith models, including their daily motion
+func CalculateAllWithOptions(t time.Time, opts Options) []Position {
 	d := DayNumber(t)
 	bodies := AllBodies()
 	positions := make([]Position, len(bodies))
 	for i, body := range bodies {
-		positions[i] = CalculateAtDay(body, d)
-		if body.CanBeRetrograde() {
-			positions[i].Retrograde = isRetrograde(body, d)
-		}
+		positions[i] = WithMotion(body, d, func(d float64) Position {
+			return CalculateAtDayWithOptions(body, d, opts)
+		})
 	}
 	return positions
 }
 
-// retrogradeCheckDelta is the number of days before/after to check motion direction.
-const retrogradeCheckDelta = 1.0
-
-// isRetrograde checks if a body is in retrograde motion at day d.
-func isRetrograde(body CelestialBody, d float64) bool {
-	posBefore := CalculateAtDay(body, d-retrogradeCheckDelta)
-	posAfter := CalculateAtDay(body, d+retrogradeCheckDelta)
-	motion := NormalizeMotion(posAfter.EclipticLongitude - posBefore.EclipticLongitude)
-	return motion < 0
-}
-
 // CalculateAscendant computes the Ascendant (rising sign) for a location and time
 func CalculateAscendant(latitude, longitude float64, t time.Time) float64 {
 	jd := JulianDay(t)
 	lst := LocalSiderealTime(jd, longitude)
-	lstRad := DegreesToRadians(lst)
+	return ascendantFor(lst, latitude)
+}
+
+// ascendantFor returns the ecliptic longitude rising on the eastern horizon
+// for a sidereal time (RAMC) and latitude, both in degrees
+func ascendantFor(ramc, latitude float64) float64 {
+	ramcRad := DegreesToRadians(ramc)
 	latRad := DegreesToRadians(latitude)
 	oblRad := DegreesToRadians(Obliquity)
 
 	// Ascendant formula
-	y := -math.Cos(lstRad)
-	x := math.Sin(lstRad)*math.Cos(oblRad) + math.Tan(latRad)*math.Sin(oblRad)
+	y := math.Cos(ramcRad)
+	x := -(math.Sin(ramcRad)*math.Cos(oblRad) + math.Tan(latRad)*math.Sin(oblRad))
 
 	asc := RadiansToDegrees(math.Atan2(y, x))
 	return NormalizeAngle(asc)
@@ -0,0 +1,73 @@
+package position
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// separation returns the absolute difference between two longitudes in degrees
+func separation(a, b float64) float64 {
+	return math.Abs(NormalizeMotion(a - b))
+}
+
+// Ascendants from Raphael's Tables of Houses for London (51°32'N), and the
+// cases fixed by geometry: with a solstice on the MC the equinoxes rise and
+// set at every latitude, and on the equator the ecliptic point at RAMC+90
+// rises
+func TestAscendantFor(t *testing.T) {
+	const london = 51 + 32.0/60
+	tests := []struct {
+		ramc, latitude, want float64
+	}{
+		{0, london, 116 + 36.0/60}, // 26°36' Cancer
+		{90, london, 180},
+		{270, london, 0},
+		{0, 0, 90},
+		{90, -33.9, 180},
+	}
+	for _, tt := range tests {
+		got := ascendantFor(tt.ramc, tt.latitude)
+		if separation(got, tt.want) > 1.0/60 {
+			t.Errorf("RAMC %g, latitude %g: ascendant %.3f, want %.3f", tt.ramc, tt.latitude, got, tt.want)
+		}
+	}
+}
+
+// Pluto on 1992 October 13.0, from Meeus, Astronomical Algorithms, example
+// 37.a: apparent α 15h31m43.7s, δ -4°27'29", converted to the ecliptic
+func TestPlutoPosition(t *testing.T) {
+	got := Calculate(Pluto, time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC))
+	if separation(got.EclipticLongitude, 231.695) > 0.1 {
+		t.Errorf("longitude %.3f, want 231.695", got.EclipticLongitude)
+	}
+	if math.Abs(got.EclipticLatitude-14.190) > 0.1 {
+		t.Errorf("latitude %.3f, want 14.190", got.EclipticLatitude)
+	}
+}
+
+// The Sun on 1992 October 13.0, from Meeus, example 25.a
+func TestSunPosition(t *testing.T) {
+	got := Calculate(Sun, time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC))
+	if separation(got.EclipticLongitude, 199.90988) > 0.01 {
+		t.Errorf("longitude %.4f, want 199.90988", got.EclipticLongitude)
+	}
+	if math.Abs(got.Distance-0.99766) > 1e-4 {
+		t.Errorf("distance %.5f AU, want 0.99766", got.Distance)
+	}
+}
+
+// The Moon on 1992 April 12.0, from Meeus, example 47.a: λ 133.162655°,
+// β -3.229126°, Δ 368409.7 km
+func TestMoonPosition(t *testing.T) {
+	got := Calculate(Moon, time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC))
+	if separation(got.EclipticLongitude, 133.162655) > 0.05 {
+		t.Errorf("longitude %.4f, want 133.1627", got.EclipticLongitude)
+	}
+	if math.Abs(got.EclipticLatitude+3.229126) > 0.05 {
+		t.Errorf("latitude %.4f, want -3.2291", got.EclipticLatitude)
+	}
+	if want := 368409.7 / 149597870.7; math.Abs(got.Distance-want) > 1e-5 {
+		t.Errorf("distance %.7f AU, want %.7f", got.Distance, want)
+	}
+}
@@ -0,0 +1,31 @@
+package position
+
+import "time"
+
+// CalculateAngularPoints computes the Vertex, Anti-Vertex and East Point
+// for a location and time
+func CalculateAngularPoints(latitude, longitude float64, t time.Time) []Position {
+	ramc := LocalSiderealTime(JulianDay(t), longitude)
+
+	vertex := CalculateVertex(latitude, longitude, t)
+	eastPoint := ascendantFor(ramc, 0)
+
+	return []Position{
+		Position{Body: Vertex, EclipticLongitude: vertex}.WithEquatorial(),
+		Position{Body: AntiVertex, EclipticLongitude: NormalizeAngle(vertex + 180)}.WithEquatorial(),
+		Position{Body: EastPoint, EclipticLongitude: eastPoint}.WithEquatorial(),
+	}
+}
+
+// CalculateVertex computes the Vertex, the western intersection of the
+// prime vertical with the ecliptic. It is the Ascendant computed for the
+// co-latitude with the RAMC of the IC.
+func CalculateVertex(latitude, longitude float64, t time.Time) float64 {
+	ramc := LocalSiderealTime(JulianDay(t), longitude)
+
+	coLatitude := 90 - latitude
+	if latitude < 0 {
+		coLatitude = -90 - latitude
+	}
+	return ascendantFor(NormalizeAngle(ramc+180), coLatitude)
+}
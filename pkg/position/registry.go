@@ -0,0 +1,318 @@
+package position
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// firstRegisteredBody is the identifier given to the first registered body,
+// well above the built-in bodies so their ordering is not affected
+const firstRegisteredBody CelestialBody = 1000
+
+// gaussianDailyMotion is the mean daily motion (degrees/day) of a body at 1 AU
+const gaussianDailyMotion = 0.9856076686
+
+// registeredStationWindow is the station window (days) of registered bodies
+const registeredStationWindow = 4
+
+// generalPrecession is the precession of the equinoxes along the ecliptic
+// (degrees/day), 50.29" a year
+const generalPrecession = 50.29 / 3600 / 365.25
+
+// ElementSet is a set of osculating orbital elements for an extra body,
+// as read from an element file. Angles are in degrees, J2000 ecliptic.
+type ElementSet struct {
+	Name         string  `json:"name"`
+	Symbol       string  `json:"symbol,omitempty"`
+	Epoch        float64 `json:"epoch"`       // Julian Day of the elements
+	MeanAnomaly  float64 `json:"m"`           // Mean anomaly at epoch
+	Perihelion   float64 `json:"peri"`        // Argument of perihelion
+	Node         float64 `json:"node"`        // Longitude of ascending node
+	Inclination  float64 `json:"i"`           // Inclination
+	Eccentricity float64 `json:"e"`           // Eccentricity
+	SemiMajor    float64 `json:"a"`           // Semi-major axis (AU)
+	DailyMotion  float64 `json:"n,omitempty"` // Mean daily motion (degrees/day), derived from a if zero
+}
+
+// registeredBody is a body added to the registry at run time
+type registeredBody struct {
+	name   string
+	symbol string
+}
+
+var (
+	registered     []CelestialBody
+	registeredInfo = map[CelestialBody]registeredBody{}
+)
+
+// RegisterBody adds a body with its orbital elements to the registry and
+// returns its identifier. Registering a name again replaces its elements.
+// Bodies must be registered before positions are calculated.
+func RegisterBody(set ElementSet) (CelestialBody, error) {
+	if err := set.validate(); err != nil {
+		return 0, err
+	}
+
+	body, ok := RegisteredBodyByName(set.Name)
+	if !ok {
+		body = firstRegisteredBody + CelestialBody(len(registered))
+		registered = append(registered, body)
+	}
+
+	symbol := set.Symbol
+	if symbol == "" {
+		symbol = defaultSymbol(set.Name)
+	}
+	registeredInfo[body] = registeredBody{name: set.Name, symbol: symbol}
+
+	elements := set.orbitalElements()
+	PlanetElements[body] = elements
+	meanDailyMotion[body] = elements.MRate
+	stationWindows[body] = registeredStationWindow
+	return body, nil
+}
+
+// RegisteredBodies returns the registered bodies in registration order
+func RegisteredBodies() []CelestialBody {
+	return append([]CelestialBody(nil), registered...)
+}
+
+// RegisteredBodyByName looks up a registered body by name, ignoring case
+func RegisteredBodyByName(name string) (CelestialBody, bool) {
+	for _, body := range registered {
+		if strings.EqualFold(registeredInfo[body].name, name) {
+			return body, true
+		}
+	}
+	return 0, false
+}
+
+// BodyByName looks up a body or point by its English name, ignoring case,
+// spaces and dashes, then among the registered bodies
+func BodyByName(name string) (CelestialBody, bool) {
+	key := bodyKey(name)
+	for body, n := range bodyNames {
+		if bodyKey(n) == key {
+			return body, true
+		}
+	}
+	return RegisteredBodyByName(name)
+}
+
+func bodyKey(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name))
+}
+
+// IsRegistered reports whether the body was added through the registry
+func (b CelestialBody) IsRegistered() bool {
+	_, ok := registeredInfo[b]
+	return ok
+}
+
+// LoadElementsFile registers every body of an element file. Files ending
+// in .json hold an array of ElementSet; anything else is read as MPCORB.
+func LoadElementsFile(path string) ([]CelestialBody, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var sets []ElementSet
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		sets, err = ParseElementsJSON(f)
+	} else {
+		sets, err = ParseMPCORB(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	bodies := make([]CelestialBody, 0, len(sets))
+	for _, set := range sets {
+		body, err := RegisterBody(set)
+		if err != nil {
+			return bodies, fmt.Errorf("%s: %w", path, err)
+		}
+		bodies = append(bodies, body)
+	}
+	return bodies, nil
+}
+
+// ParseElementsJSON reads an array of element sets
+func ParseElementsJSON(r io.Reader) ([]ElementSet, error) {
+	var sets []ElementSet
+	if err := json.NewDecoder(r).Decode(&sets); err != nil {
+		return nil, fmt.Errorf("invalid element JSON: %w", err)
+	}
+	return sets, nil
+}
+
+// ParseMPCORB reads element sets in the Minor Planet Center MPCORB format,
+// one fixed-width line per body. Header and blank lines are skipped.
+func ParseMPCORB(r io.Reader) ([]ElementSet, error) {
+	var sets []ElementSet
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if len(text) < 103 || !isMPCEpoch(text[20:25]) {
+			continue
+		}
+		set, err := parseMPCLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		sets = append(sets, set)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sets, nil
+}
+
+// parseMPCLine decodes one MPCORB record (columns per the MPC documentation)
+func parseMPCLine(text string) (ElementSet, error) {
+	field := func(from, to int) string {
+		if to > len(text) {
+			to = len(text)
+		}
+		return strings.TrimSpace(text[from-1 : to])
+	}
+
+	epoch, err := unpackMPCEpoch(text[20:25])
+	if err != nil {
+		return ElementSet{}, err
+	}
+
+	values := make([]float64, 7)
+	columns := [][2]int{{27, 35}, {38, 46}, {49, 57}, {60, 68}, {71, 79}, {81, 91}, {93, 103}}
+	for i, col := range columns {
+		if values[i], err = strconv.ParseFloat(field(col[0], col[1]), 64); err != nil {
+			return ElementSet{}, fmt.Errorf("invalid element in columns %d-%d: %w", col[0], col[1], err)
+		}
+	}
+
+	name := field(1, 7)
+	if readable := field(167, 194); readable != "" {
+		name = mpcName(readable)
+	}
+
+	return ElementSet{
+		Name:         name,
+		Epoch:        epoch,
+		MeanAnomaly:  values[0],
+		Perihelion:   values[1],
+		Node:         values[2],
+		Inclination:  values[3],
+		Eccentricity: values[4],
+		DailyMotion:  values[5],
+		SemiMajor:    values[6],
+	}, nil
+}
+
+// mpcName strips the number from a readable designation: "(136199) Eris" gives "Eris"
+func mpcName(readable string) string {
+	if strings.HasPrefix(readable, "(") {
+		if i := strings.Index(readable, ")"); i >= 0 && i+1 < len(readable) {
+			return strings.TrimSpace(readable[i+1:])
+		}
+	}
+	return readable
+}
+
+// isMPCEpoch reports whether s looks like a packed epoch such as "K24AH"
+func isMPCEpoch(s string) bool {
+	return len(s) == 5 && s[0] >= 'I' && s[0] <= 'L' &&
+		unicode.IsDigit(rune(s[1])) && unicode.IsDigit(rune(s[2]))
+}
+
+// unpackMPCEpoch converts a packed MPC date (century letter, two-digit
+// year, month and day as 1-9 then A-V) to a Julian Day at 0h TT
+func unpackMPCEpoch(s string) (float64, error) {
+	if !isMPCEpoch(s) {
+		return 0, fmt.Errorf("invalid packed epoch %q", s)
+	}
+	year := 1800 + int(s[0]-'I')*100 + int(s[1]-'0')*10 + int(s[2]-'0')
+	month, okM := unpackMPCDigit(s[3])
+	day, okD := unpackMPCDigit(s[4])
+	if !okM || !okD || month < 1 || month > 12 || day < 1 {
+		return 0, fmt.Errorf("invalid packed epoch %q", s)
+	}
+	return calendarToJulianDay(year, month, day), nil
+}
+
+func unpackMPCDigit(c byte) (int, bool) {
+	switch {
+	case c >= '1' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'V':
+		return int(c-'A') + 10, true
+	}
+	return 0, false
+}
+
+// calendarToJulianDay returns the Julian Day at 0h of a Gregorian date
+func calendarToJulianDay(year, month, day int) float64 {
+	if month <= 2 {
+		year--
+		month += 12
+	}
+	a := year / 100
+	b := 2 - a + a/4
+	return math.Floor(365.25*float64(year+4716)) + math.Floor(30.6001*float64(month+1)) +
+		float64(day) + float64(b) - 1524.5
+}
+
+// validate checks that the element set describes a bound orbit
+func (s ElementSet) validate() error {
+	switch {
+	case strings.TrimSpace(s.Name) == "":
+		return fmt.Errorf("element set without a name")
+	case s.Eccentricity < 0 || s.Eccentricity >= 1:
+		return fmt.Errorf("%s: eccentricity %g is not an elliptical orbit", s.Name, s.Eccentricity)
+	case s.SemiMajor <= 0:
+		return fmt.Errorf("%s: semi-major axis must be positive", s.Name)
+	case s.Epoch == 0:
+		return fmt.Errorf("%s: missing epoch", s.Name)
+	}
+	return nil
+}
+
+// orbitalElements converts the element set to elements at the ElementsEpoch.
+// The orbit is kept fixed, but element files use the J2000 equinox while
+// positions are given for the equinox of date, so the node follows the
+// precession of the equinoxes.
+func (s ElementSet) orbitalElements() OrbitalElements {
+	n := s.DailyMotion
+	if n == 0 {
+		n = gaussianDailyMotion / math.Pow(s.SemiMajor, 1.5)
+	}
+	return OrbitalElements{
+		N:     NormalizeAngle(s.Node + generalPrecession*(ElementsEpoch-J2000)),
+		NRate: generalPrecession,
+		I:     s.Inclination,
+		W:     s.Perihelion,
+		A:     s.SemiMajor,
+		E:     s.Eccentricity,
+		M:     NormalizeAngle(s.MeanAnomaly + n*(ElementsEpoch-s.Epoch)),
+		MRate: n,
+	}
+}
+
+// defaultSymbol abbreviates a body name for the wheel: "Sedna" gives "Se"
+func defaultSymbol(name string) string {
+	runes := []rune(strings.TrimSpace(name))
+	if len(runes) > 2 {
+		runes = runes[:2]
+	}
+	return string(runes)
+}
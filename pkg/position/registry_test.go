@@ -0,0 +1,41 @@
+package position
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// A registered orbit is referred to the J2000 equinox: in 2026 it lies
+// about 0.36° (26 years of precession) ahead of the same orbit taken as
+// referred to the equinox of date
+func TestRegisteredBodyPrecession(t *testing.T) {
+	ceres := PlanetElements[Ceres]
+	body, err := RegisterBody(ElementSet{
+		Name:         "Ceres J2000",
+		Epoch:        ElementsEpoch,
+		MeanAnomaly:  ceres.M,
+		Perihelion:   ceres.W,
+		Node:         ceres.N,
+		Inclination:  ceres.I,
+		Eccentricity: ceres.E,
+		SemiMajor:    ceres.A,
+		DailyMotion:  ceres.MRate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := DayNumber(at)
+	got := CalculateHeliocentricAtDay(body, d)
+	fixed := CalculateHeliocentricAtDay(Ceres, d)
+
+	want := 50.29 / 3600 * (JulianDay(at) - J2000) / 365.25
+	if shift := NormalizeMotion(got.EclipticLongitude - fixed.EclipticLongitude); math.Abs(shift-want) > 1e-6 {
+		t.Errorf("longitude shift %.6f°, want %.6f°", shift, want)
+	}
+	if math.Abs(got.EclipticLatitude-fixed.EclipticLatitude) > 1e-9 {
+		t.Errorf("latitude %.6f, want %.6f", got.EclipticLatitude, fixed.EclipticLatitude)
+	}
+}
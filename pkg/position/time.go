@@ -5,15 +5,20 @@ import "time"
 // J2000 is the Julian Day number for January 1, 2000 at 12:00 TT
 const J2000 = 2451545.0
 
-// DayNumber calculates the day number relative to J2000.0 epoch
+// ElementsEpoch is the Julian Day of the epoch of the orbital elements,
+// 1999 December 31 at 0:00 (Schlyter's day zero), 1.5 days before J2000
+const ElementsEpoch = 2451543.5
+
+// DayNumber calculates the day number relative to the orbital elements epoch
 // This is used as the primary time parameter in orbital calculations
 func DayNumber(t time.Time) float64 {
-	return JulianDay(t) - J2000
+	return JulianDay(t) - ElementsEpoch
 }
 
 // JulianDay converts a time.Time to Julian Day number
 // Formula from Meeus, Astronomical Algorithms
 func JulianDay(t time.Time) float64 {
+	t = t.UTC()
 	year := t.Year()
 	month := int(t.Month())
 	day := float64(t.Day()) + float64(t.Hour())/24.0 +
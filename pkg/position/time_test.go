@@ -0,0 +1,28 @@
+package position
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// Meeus, Astronomical Algorithms, example 7.a: 1957 October 4.81 is JD
+// 2436116.31, whatever zone the instant is given in
+func TestJulianDay(t *testing.T) {
+	instant := time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC)
+	for _, loc := range []*time.Location{time.UTC, time.FixedZone("UTC+2", 2*3600), time.FixedZone("UTC-5", -5*3600)} {
+		if got := JulianDay(instant.In(loc)); math.Abs(got-2436116.31) > 1e-6 {
+			t.Errorf("%s: JD %.6f, want 2436116.31", loc, got)
+		}
+	}
+}
+
+func TestDayNumber(t *testing.T) {
+	epoch := time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if got := DayNumber(epoch); got != 0 {
+		t.Errorf("day number at the elements epoch %g, want 0", got)
+	}
+	if got := DayNumber(epoch.Add(36 * time.Hour)); got != J2000-ElementsEpoch {
+		t.Errorf("day number at J2000 %g, want %g", got, J2000-ElementsEpoch)
+	}
+}
@@ -0,0 +1,201 @@
+package profile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// ReadAAF reads the Astrodienst AAF exchange format, where each record is a
+// #A93 line (name, first name, sex, date, time, place, country) followed by
+// a #B93 line (Julian Day in UT, latitude, longitude, time zone, flags):
+//
+//	#A93:Einstein,Albert,m,14.3.1879g,11:30,Ulm,D
+//	#B93:2407422.95139,48n24,10e0,0h40e,0
+//
+// Dates end with g for the Gregorian calendar or j for the Julian one.
+// Records without a #B93 line need geocoding.
+func ReadAAF(r io.Reader, source string) ([]Entry, error) {
+	var entries []Entry
+	var current *Entry
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(text, "#A93:"):
+			if current != nil {
+				entries = append(entries, *current)
+			}
+			e := parseAAFA(strings.TrimPrefix(text, "#A93:"))
+			e.Line = line
+			e.Profile.Source = source
+			current = &e
+		case strings.HasPrefix(text, "#B93:"):
+			if current == nil {
+				entries = append(entries, Entry{Line: line, Err: fmt.Errorf("#B93 line without #A93")})
+				continue
+			}
+			if current.Err == nil {
+				current.Err = parseAAFB(strings.TrimPrefix(text, "#B93:"), current)
+			}
+			entries = append(entries, *current)
+			current = nil
+		}
+	}
+	if current != nil {
+		entries = append(entries, *current)
+	}
+	return entries, scanner.Err()
+}
+
+// parseAAFA reads the name, local date and time and place of a #A93 line.
+// The time is provisionally in UTC until the zone is known.
+func parseAAFA(text string) Entry {
+	fields := strings.Split(text, ",")
+	for len(fields) < 7 {
+		fields = append(fields, "")
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	e := Entry{NeedsGeocoding: true}
+	e.Profile.Name = strings.TrimSpace(strings.Trim(fields[1]+" "+fields[0], "* "))
+	e.Place = strings.Trim(strings.Join(nonEmpty(fields[5], fields[6]), ", "), "*")
+	e.Profile.Location = e.Place
+
+	clock := fields[4]
+	if clock == "" || clock == "*" {
+		clock = "12:00"
+	}
+	date, julian := strings.CutSuffix(strings.TrimSuffix(strings.ToLower(fields[3]), "g"), "j")
+	dt, err := time.Parse("2.1.2006 15:04", date+" "+clock)
+	if err != nil {
+		e.Err = fmt.Errorf("invalid date or time %q %q", fields[3], fields[4])
+		return e
+	}
+	if julian {
+		dt = julianToGregorian(dt)
+	}
+	e.Profile.DateTime = dt
+	e.NeedsZone = true
+	return e
+}
+
+// julianToGregorian converts a date of the Julian calendar, read as if it
+// were Gregorian, to the same day in the Gregorian calendar
+func julianToGregorian(t time.Time) time.Time {
+	a := (14 - int(t.Month())) / 12
+	y := t.Year() + 4800 - a
+	m := int(t.Month()) + 12*a - 3
+	jdn := t.Day() + (153*m+2)/5 + 365*y + y/4 - 32083
+	// Julian Day Number 2440588 is 1 January 1970
+	return time.Date(1970, 1, 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location()).AddDate(0, 0, jdn-2440588)
+}
+
+// parseAAFB completes an entry with the coordinates and time zone of a #B93 line
+func parseAAFB(text string, e *Entry) error {
+	fields := strings.Split(text, ",")
+	if len(fields) < 4 {
+		return fmt.Errorf("incomplete #B93 line")
+	}
+
+	lat, err := parseAAFCoordinate(fields[1], 'n', 's')
+	if err != nil {
+		return err
+	}
+	lon, err := parseAAFCoordinate(fields[2], 'e', 'w')
+	if err != nil {
+		return err
+	}
+	offset, err := parseAAFZone(fields[3])
+	if err != nil {
+		return err
+	}
+
+	zone := time.FixedZone(formatOffset(offset), offset)
+	local := e.Profile.DateTime
+	e.Profile.DateTime = time.Date(local.Year(), local.Month(), local.Day(),
+		local.Hour(), local.Minute(), 0, 0, zone)
+
+	// The Julian Day is exact to the second; prefer it when present
+	if jd, err := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64); err == nil {
+		e.Profile.DateTime = position.JulianDayToTime(jd).In(zone).Round(time.Minute)
+	}
+
+	e.Profile.Latitude, e.Profile.Longitude = lat, lon
+	e.NeedsGeocoding, e.NeedsZone = false, false
+	return nil
+}
+
+var aafCoordinate = regexp.MustCompile(`^(\d+)([a-z])(\d+)(?::(\d+))?$`)
+
+// parseAAFCoordinate reads "48n24" or "10e0" style coordinates
+func parseAAFCoordinate(s string, positive, negative byte) (float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	m := aafCoordinate.FindStringSubmatch(s)
+	if m == nil || (m[2][0] != positive && m[2][0] != negative) {
+		return 0, fmt.Errorf("invalid coordinate %q", s)
+	}
+	deg, _ := strconv.Atoi(m[1])
+	minutes, _ := strconv.Atoi(m[3])
+	seconds := 0
+	if m[4] != "" {
+		seconds, _ = strconv.Atoi(m[4])
+	}
+	value := float64(deg) + float64(minutes)/60 + float64(seconds)/3600
+	if m[2][0] == negative {
+		value = -value
+	}
+	return value, nil
+}
+
+var aafZone = regexp.MustCompile(`^(\d+)h([ew]?)(\d*)([ew]?)$`)
+
+// parseAAFZone reads a zone such as "1he00", "5hw" or "0h40e" as seconds
+// east of UTC
+func parseAAFZone(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	m := aafZone.FindStringSubmatch(s)
+	if m == nil || (m[2] == "" && m[4] == "" && m[1] != "0") {
+		return 0, fmt.Errorf("invalid time zone %q", s)
+	}
+	hours, _ := strconv.Atoi(m[1])
+	minutes := 0
+	if m[3] != "" {
+		minutes, _ = strconv.Atoi(m[3])
+	}
+	offset := hours*3600 + minutes*60
+	if m[2] == "w" || m[4] == "w" {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+// formatOffset names a fixed zone like "UTC+01:00"
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, v := range values {
+		if v != "" && v != "*" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
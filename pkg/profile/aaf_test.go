@@ -0,0 +1,62 @@
+package profile
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func readAAFFixture(t *testing.T, name string) []Entry {
+	t.Helper()
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+	entries, err := ReadAAF(f, name)
+	if err != nil {
+		t.Fatalf("ReadAAF: %v", err)
+	}
+	return entries
+}
+
+func TestReadAAFGregorian(t *testing.T) {
+	entries := readAAFFixture(t, "gregorian.aaf")
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	for _, e := range entries {
+		if e.Err != nil {
+			t.Fatalf("line %d: %v", e.Line, e.Err)
+		}
+	}
+
+	einstein := entries[0].Profile.DateTime
+	want := time.Date(1879, time.March, 14, 11, 30, 0, 0, time.FixedZone("", 40*60))
+	if !einstein.Equal(want) {
+		t.Errorf("Einstein born %v, want %v", einstein, want)
+	}
+
+	// Without a #B93 line, the local time waits for a zone
+	curie := entries[1]
+	if !curie.NeedsZone || !curie.NeedsGeocoding {
+		t.Error("record without #B93 should need a zone and geocoding")
+	}
+	if got := curie.Profile.DateTime.Format("2006-01-02 15:04"); got != "1867-11-07 12:00" {
+		t.Errorf("Curie born %s, want 1867-11-07 12:00", got)
+	}
+}
+
+func TestReadAAFJulian(t *testing.T) {
+	entries := readAAFFixture(t, "julian.aaf")
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	if entries[0].Err != nil {
+		t.Fatal(entries[0].Err)
+	}
+	// 25 December 1642 in the Julian calendar is 4 January 1643
+	if got := entries[0].Profile.DateTime.Format("2006-01-02 15:04"); got != "1643-01-04 01:38" {
+		t.Errorf("Newton born %s, want 1643-01-04 01:38", got)
+	}
+}
@@ -0,0 +1,250 @@
+package profile
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fields that a CSV column can be mapped to
+const (
+	FieldName      = "name"
+	FieldDate      = "date"
+	FieldTime      = "time"
+	FieldZone      = "zone"
+	FieldPlace     = "place"
+	FieldLatitude  = "lat"
+	FieldLongitude = "lon"
+)
+
+// DefaultMapping maps each field to the header names commonly used for it
+var DefaultMapping = map[string][]string{
+	FieldName:      {"name", "full name", "nom"},
+	FieldDate:      {"date", "birth date", "birthdate", "dob"},
+	FieldTime:      {"time", "birth time", "heure"},
+	FieldZone:      {"zone", "timezone", "tz", "utc offset"},
+	FieldPlace:     {"place", "city", "location", "birthplace", "lieu"},
+	FieldLatitude:  {"lat", "latitude"},
+	FieldLongitude: {"lon", "lng", "long", "longitude"},
+}
+
+// Accepted date layouts besides slash dates, tried in order
+var dateLayouts = []string{"2006-01-02", "2.1.2006"}
+
+// DateOrder tells how to read dates written with slashes
+type DateOrder string
+
+// Date orders
+const (
+	DateAuto DateOrder = "auto" // Day or month first when only one reading is valid
+	DayMonth DateOrder = "dmy"  // 03/04/1990 is 3 April
+	MonthDay DateOrder = "mdy"  // 03/04/1990 is 4 March
+)
+
+// ReadCSV reads birth records from CSV with a header row. mapping gives,
+// for each field, the header names that may hold it; fields missing from
+// mapping fall back to DefaultMapping. Slash dates are read in order; with
+// DateAuto, dates such as 03/04/1990 that read both ways fail. Records
+// without coordinates need geocoding and records without a zone need a
+// default zone.
+func ReadCSV(r io.Reader, source string, mapping map[string][]string, order DateOrder) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read CSV header: %w", err)
+	}
+	columns := make(map[string]int)
+	for field, defaults := range DefaultMapping {
+		names := defaults
+		if custom, ok := mapping[field]; ok {
+			names = custom
+		}
+		for i, h := range header {
+			if containsFold(names, strings.TrimSpace(h)) {
+				columns[field] = i
+				break
+			}
+		}
+	}
+	if _, ok := columns[FieldDate]; !ok {
+		return nil, fmt.Errorf("CSV header has no date column")
+	}
+
+	var entries []Entry
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// FieldPos has no fields to report after a failed read
+			var line int
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				line = pe.StartLine
+			}
+			entries = append(entries, Entry{Line: line, Err: err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		get := func(field string) string {
+			if i, ok := columns[field]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		e := parseCSVRow(get, order)
+		e.Line = line
+		e.Profile.Source = source
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// parseCSVRow builds an entry from the mapped fields of a row
+func parseCSVRow(get func(string) string, order DateOrder) Entry {
+	e := Entry{Place: get(FieldPlace)}
+	e.Profile.Name = get(FieldName)
+	e.Profile.Location = e.Place
+
+	var zone *time.Location
+	if z := get(FieldZone); z != "" {
+		loc, name, err := parseZone(z)
+		if err != nil {
+			e.Err = err
+			return e
+		}
+		zone, e.Profile.Timezone = loc, name
+	} else {
+		zone, e.NeedsZone = time.UTC, true
+	}
+
+	clock := get(FieldTime)
+	if clock == "" {
+		clock = "12:00"
+	}
+	date, err := parseDate(get(FieldDate), order)
+	if err != nil {
+		e.Err = err
+		return e
+	}
+	h, m, s, err := parseClock(clock)
+	if err != nil {
+		e.Err = err
+		return e
+	}
+	e.Profile.DateTime = time.Date(date.Year(), date.Month(), date.Day(), h, m, s, 0, zone)
+
+	lat, lon := get(FieldLatitude), get(FieldLongitude)
+	if lat == "" || lon == "" {
+		e.NeedsGeocoding = true
+		if e.Place == "" {
+			e.Err = fmt.Errorf("no coordinates and no place")
+		}
+		return e
+	}
+	if e.Profile.Latitude, err = strconv.ParseFloat(lat, 64); err != nil {
+		e.Err = fmt.Errorf("invalid latitude %q", lat)
+		return e
+	}
+	if e.Profile.Longitude, err = strconv.ParseFloat(lon, 64); err != nil {
+		e.Err = fmt.Errorf("invalid longitude %q", lon)
+	}
+	return e
+}
+
+func parseDate(s string, order DateOrder) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if d, err := time.Parse(layout, s); err == nil {
+			return d, nil
+		}
+	}
+	if strings.Count(s, "/") == 2 {
+		return parseSlashDate(s, order)
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+// parseSlashDate reads a day/month/year or month/day/year date
+func parseSlashDate(s string, order DateOrder) (time.Time, error) {
+	parts := strings.Split(s, "/")
+	dmy, dmyErr := time.Parse("2/1/2006", parts[0]+"/"+parts[1]+"/"+parts[2])
+	mdy, mdyErr := time.Parse("2/1/2006", parts[1]+"/"+parts[0]+"/"+parts[2])
+	switch {
+	case order == DayMonth && dmyErr == nil:
+		return dmy, nil
+	case order == MonthDay && mdyErr == nil:
+		return mdy, nil
+	case order != DateAuto:
+	case dmyErr == nil && mdyErr == nil && !dmy.Equal(mdy):
+		return time.Time{}, fmt.Errorf("ambiguous date %q: set the date order (dmy or mdy)", s)
+	case dmyErr == nil:
+		return dmy, nil
+	case mdyErr == nil:
+		return mdy, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+func parseClock(s string) (int, int, int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, fmt.Errorf("invalid time %q", s)
+	}
+	values := make([]int, 3)
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid time %q", s)
+		}
+		values[i] = v
+	}
+	if values[0] > 23 || values[1] > 59 || values[2] > 59 {
+		return 0, 0, 0, fmt.Errorf("invalid time %q", s)
+	}
+	return values[0], values[1], values[2], nil
+}
+
+var offsetZone = regexp.MustCompile(`^(?:UTC|GMT)?([+-])(\d{1,2})(?::?(\d{2}))?$`)
+
+// parseZone reads an IANA zone name or a UTC offset such as "+02:00" or
+// "UTC-5", returning the location and the IANA name if any
+func parseZone(s string) (*time.Location, string, error) {
+	if strings.EqualFold(s, "UTC") || strings.EqualFold(s, "GMT") {
+		return time.UTC, "UTC", nil
+	}
+	if m := offsetZone.FindStringSubmatch(strings.ToUpper(s)); m != nil {
+		hours, _ := strconv.Atoi(m[2])
+		minutes := 0
+		if m[3] != "" {
+			minutes, _ = strconv.Atoi(m[3])
+		}
+		offset := hours*3600 + minutes*60
+		if m[1] == "-" {
+			offset = -offset
+		}
+		return time.FixedZone(formatOffset(offset), offset), "", nil
+	}
+	loc, err := time.LoadLocation(s)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid time zone %q", s)
+	}
+	return loc, s, nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package profile
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadCSVBadQuote(t *testing.T) {
+	input := "name,date,time,lat,lon\n" +
+		"Ada,1990-01-01,12:00,48.85,2.35\n" +
+		"\"a\"b,1990-01-01,12:00,48.85,2.35\n"
+
+	entries, err := ReadCSV(strings.NewReader(input), "test.csv", nil, DateAuto)
+	if err != nil {
+		t.Fatalf("ReadCSV: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if entries[0].Err != nil {
+		t.Errorf("line 2: unexpected error %v", entries[0].Err)
+	}
+	if entries[1].Err == nil {
+		t.Fatal("line 3: bad quote not reported")
+	}
+	if entries[1].Line != 3 {
+		t.Errorf("bad quote reported on line %d, want 3", entries[1].Line)
+	}
+}
+
+func TestParseSlashDate(t *testing.T) {
+	tests := []struct {
+		date  string
+		order DateOrder
+		want  time.Time
+		fails bool
+	}{
+		{date: "25/12/1990", order: DateAuto, want: date(1990, 12, 25)},
+		{date: "12/25/1990", order: DateAuto, want: date(1990, 12, 25)},
+		{date: "04/04/1990", order: DateAuto, want: date(1990, 4, 4)},
+		{date: "03/04/1990", order: DateAuto, fails: true},
+		{date: "03/04/1990", order: DayMonth, want: date(1990, 4, 3)},
+		{date: "03/04/1990", order: MonthDay, want: date(1990, 3, 4)},
+		{date: "12/25/1990", order: DayMonth, fails: true},
+		{date: "31/02/1990", order: DateAuto, fails: true},
+	}
+	for _, tt := range tests {
+		got, err := parseDate(tt.date, tt.order)
+		switch {
+		case tt.fails && err == nil:
+			t.Errorf("parseDate(%q, %s) = %v, want an error", tt.date, tt.order, got)
+		case !tt.fails && err != nil:
+			t.Errorf("parseDate(%q, %s): %v", tt.date, tt.order, err)
+		case !tt.fails && !got.Equal(tt.want):
+			t.Errorf("parseDate(%q, %s) = %v, want %v", tt.date, tt.order, got, tt.want)
+		}
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,145 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Entry is a record read from an import file, before it is resolved
+type Entry struct {
+	Line           int
+	Profile        Profile
+	Place          string // Place name to geocode when coordinates are missing
+	NeedsGeocoding bool
+	NeedsZone      bool // DateTime holds the local clock time in UTC
+	Err            error
+}
+
+// Geocoder finds the coordinates of a place name
+type Geocoder interface {
+	Geocode(place string) (latitude, longitude float64, name string, err error)
+}
+
+// Import stages at which a record can fail
+const (
+	StageParse   = "parse"
+	StageGeocode = "geocode"
+	StageZone    = "zone"
+)
+
+// Failure is a record that could not be imported
+type Failure struct {
+	Line  int    `json:"line"`
+	Name  string `json:"name,omitempty"`
+	Stage string `json:"stage"`
+	Error string `json:"error"`
+}
+
+// Report is the outcome of an import
+type Report struct {
+	Source     string    `json:"source"`
+	Read       int       `json:"read"`
+	Resolved   []Profile `json:"resolved"`
+	Failures   []Failure `json:"failures"`
+	Duplicates int       `json:"duplicates"`
+}
+
+// ResolveOptions control how entries become profiles
+type ResolveOptions struct {
+	Geocoder    Geocoder       // nil fails entries that need geocoding
+	DefaultZone *time.Location // nil fails entries without a zone
+}
+
+// Resolve geocodes and completes the entries, sorting them into resolved
+// profiles and failures. Places are geocoded once each.
+func Resolve(source string, entries []Entry, opts ResolveOptions) Report {
+	report := Report{Source: source, Read: len(entries)}
+	places := make(map[string]geocoded)
+
+	for _, e := range entries {
+		fail := func(stage string, err error) {
+			report.Failures = append(report.Failures, Failure{
+				Line: e.Line, Name: e.Profile.Name, Stage: stage, Error: err.Error(),
+			})
+		}
+		if e.Err != nil {
+			fail(StageParse, e.Err)
+			continue
+		}
+
+		p := e.Profile
+		if e.NeedsZone {
+			if opts.DefaultZone == nil {
+				fail(StageZone, fmt.Errorf("no time zone; give a default zone"))
+				continue
+			}
+			local := p.DateTime
+			p.DateTime = time.Date(local.Year(), local.Month(), local.Day(),
+				local.Hour(), local.Minute(), local.Second(), 0, opts.DefaultZone)
+			p.Timezone = opts.DefaultZone.String()
+		}
+
+		if e.NeedsGeocoding {
+			if opts.Geocoder == nil {
+				fail(StageGeocode, fmt.Errorf("no coordinates for %q and geocoding is disabled", e.Place))
+				continue
+			}
+			g, ok := places[e.Place]
+			if !ok {
+				g.latitude, g.longitude, g.name, g.err = opts.Geocoder.Geocode(e.Place)
+				places[e.Place] = g
+			}
+			if g.err != nil {
+				fail(StageGeocode, g.err)
+				continue
+			}
+			p.Latitude, p.Longitude = g.latitude, g.longitude
+			if p.Location == "" {
+				p.Location = g.name
+			}
+		}
+
+		if err := p.Record().Validate(); err != nil {
+			fail(StageParse, err)
+			continue
+		}
+		report.Resolved = append(report.Resolved, p)
+	}
+	return report
+}
+
+type geocoded struct {
+	latitude, longitude float64
+	name                string
+	err                 error
+}
+
+// Apply adds the resolved profiles to the store, counting duplicates
+func (r *Report) Apply(s *Store) {
+	for _, p := range r.Resolved {
+		if !s.Add(p) {
+			r.Duplicates++
+		}
+	}
+}
+
+// WriteText writes a readable summary listing every failure
+func (r Report) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s: %d read, %d resolved, %d failed", r.Source, r.Read, len(r.Resolved), len(r.Failures))
+	if err != nil {
+		return err
+	}
+	if r.Duplicates > 0 {
+		fmt.Fprintf(w, ", %d already in the store", r.Duplicates)
+	}
+	fmt.Fprintln(w)
+	for _, f := range r.Failures {
+		name := f.Name
+		if name == "" {
+			name = "-"
+		}
+		fmt.Fprintf(w, "  line %d\t%s\t%s: %s\n", f.Line, name, f.Stage, f.Error)
+	}
+	return nil
+}
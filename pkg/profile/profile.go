@@ -0,0 +1,107 @@
+// Package profile stores birth records and imports them from other
+// astrology software.
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+)
+
+// Profile is a complete birth record
+type Profile struct {
+	Name      string    `json:"name"`
+	DateTime  time.Time `json:"datetime"`
+	Timezone  string    `json:"timezone,omitempty"` // IANA name when known
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Location  string    `json:"location,omitempty"`
+	Source    string    `json:"source,omitempty"` // File the profile was imported from
+}
+
+// Record converts the profile to a batch record
+func (p Profile) Record() batch.Record {
+	return batch.Record{
+		Name:      p.Name,
+		DateTime:  p.DateTime,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		Location:  p.Location,
+	}
+}
+
+// same reports whether two profiles describe the same birth
+func (p Profile) same(o Profile) bool {
+	return strings.EqualFold(p.Name, o.Name) && p.DateTime.Equal(o.DateTime)
+}
+
+// Store is a list of profiles saved as a JSON file
+type Store struct {
+	path     string
+	Profiles []Profile `json:"profiles"`
+}
+
+// DefaultStorePath returns the profile file in the user configuration directory
+func DefaultStorePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "astral-tui", "profiles.json"), nil
+}
+
+// OpenStore reads the store at path; a missing file gives an empty store
+func OpenStore(path string) (*Store, error) {
+	s := &Store{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("invalid profile store %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Add appends a profile unless one with the same name and birth time
+// exists, and reports whether it was added
+func (s *Store) Add(p Profile) bool {
+	if slices.ContainsFunc(s.Profiles, p.same) {
+		return false
+	}
+	s.Profiles = append(s.Profiles, p)
+	return true
+}
+
+// Find returns the profile with the given name, ignoring case
+func (s *Store) Find(name string) (Profile, bool) {
+	i := slices.IndexFunc(s.Profiles, func(p Profile) bool {
+		return strings.EqualFold(p.Name, name)
+	})
+	if i < 0 {
+		return Profile{}, false
+	}
+	return s.Profiles[i], true
+}
+
+// Save writes the store back to its file
+func (s *Store) Save() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, append(data, '\n'), 0o644)
+}
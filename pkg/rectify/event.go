@@ -0,0 +1,144 @@
+package rectify
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Kind is the area of life of an event, which selects the angles and
+// houses it is expected to hit
+type Kind string
+
+// Event kinds.
+const (
+	General      Kind = "general"
+	Career       Kind = "career"
+	Relationship Kind = "relationship"
+	Home         Kind = "home"
+	Children     Kind = "children"
+	Health       Kind = "health"
+	Money        Kind = "money"
+	Travel       Kind = "travel"
+	Death        Kind = "death"
+)
+
+// target lists the angles and houses signified by a kind of event
+type target struct {
+	angles []Angle
+	houses []int
+}
+
+var kindTargets = map[Kind]target{
+	General:      {angles: []Angle{Ascendant, Midheaven, Descendant, ImumCoeli}},
+	Career:       {angles: []Angle{Midheaven}, houses: []int{10}},
+	Relationship: {angles: []Angle{Descendant}, houses: []int{7}},
+	Home:         {angles: []Angle{ImumCoeli}, houses: []int{4}},
+	Children:     {angles: []Angle{Descendant, ImumCoeli}, houses: []int{5}},
+	Health:       {angles: []Angle{Ascendant}, houses: []int{1, 6}},
+	Money:        {angles: []Angle{ImumCoeli}, houses: []int{2}},
+	Travel:       {angles: []Angle{Ascendant, Midheaven}, houses: []int{9}},
+	Death:        {angles: []Angle{ImumCoeli, Ascendant}, houses: []int{8, 4}},
+}
+
+// Kinds returns the event kinds in alphabetical order
+func Kinds() []Kind {
+	kinds := make([]Kind, 0, len(kindTargets))
+	for k := range kindTargets {
+		kinds = append(kinds, k)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+	return kinds
+}
+
+// ParseKind reads an event kind, ignoring case. An empty kind is General.
+func ParseKind(s string) (Kind, error) {
+	k := Kind(strings.ToLower(strings.TrimSpace(s)))
+	if k == "" {
+		return General, nil
+	}
+	if _, ok := kindTargets[k]; !ok {
+		return "", fmt.Errorf("unknown event kind %q (one of %s)", s, kindList())
+	}
+	return k, nil
+}
+
+func kindList() string {
+	names := make([]string, 0, len(kindTargets))
+	for _, k := range Kinds() {
+		names = append(names, string(k))
+	}
+	return strings.Join(names, ", ")
+}
+
+// Event is a dated life event
+type Event struct {
+	Date  time.Time `json:"date"`
+	Kind  Kind      `json:"kind"`
+	Label string    `json:"label,omitempty"`
+}
+
+// String names the event by its label, or its kind, and date
+func (e Event) String() string {
+	name := e.Label
+	if name == "" {
+		name = string(e.Kind)
+	}
+	return fmt.Sprintf("%s %s", e.Date.Format("2006-01-02"), name)
+}
+
+// ParseEvent reads an event written as "YYYY-MM-DD[,kind[,label]]".
+// Events happen at noon UTC, their time being rarely known.
+func ParseEvent(s string) (Event, error) {
+	fields := strings.SplitN(s, ",", 3)
+	return parseFields(fields)
+}
+
+// ReadEvents reads events from CSV with the columns date, kind and label.
+// The kind and label are optional; a header line is skipped.
+func ReadEvents(r io.Reader) ([]Event, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+
+	var events []Event
+	for line := 1; ; line++ {
+		fields, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(fields[0]), "date") {
+			continue
+		}
+		e, err := parseFields(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		events = append(events, e)
+	}
+}
+
+func parseFields(fields []string) (Event, error) {
+	date, err := time.Parse("2006-01-02", strings.TrimSpace(fields[0]))
+	if err != nil {
+		return Event{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", fields[0])
+	}
+	e := Event{Date: date.Add(12 * time.Hour), Kind: General}
+	if len(fields) > 1 {
+		if e.Kind, err = ParseKind(fields[1]); err != nil {
+			return Event{}, err
+		}
+	}
+	if len(fields) > 2 {
+		e.Label = strings.TrimSpace(fields[2])
+	}
+	return e, nil
+}
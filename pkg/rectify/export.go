@@ -0,0 +1,40 @@
+package rectify
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Export is a serializable rectification with its best candidates
+type Export struct {
+	From       time.Time   `json:"from"`
+	To         time.Time   `json:"to"`
+	Latitude   float64     `json:"latitude"`
+	Longitude  float64     `json:"longitude"`
+	Events     []Event     `json:"events"`
+	Candidates []Candidate `json:"candidates"`
+}
+
+// NewExport builds the export of a rectification
+func NewExport(from, to time.Time, latitude, longitude float64, events []Event, candidates []Candidate) Export {
+	e := Export{
+		From:       from,
+		To:         to,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		Events:     events,
+		Candidates: candidates,
+	}
+	if e.Candidates == nil {
+		e.Candidates = make([]Candidate, 0)
+	}
+	return e
+}
+
+// WriteJSON writes the export as indented JSON
+func (e Export) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
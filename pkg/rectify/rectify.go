@@ -0,0 +1,384 @@
+// Package rectify estimates an unknown birth time from dated life events.
+// Each candidate time of a window is scored by the transits, solar arc
+// directions and progressions that hit its angles and house rulers on the
+// dates of the events.
+package rectify
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/internal/house"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Angle is one of the four angles of a chart
+type Angle int
+
+// Chart angles.
+const (
+	Ascendant Angle = iota
+	Midheaven
+	Descendant
+	ImumCoeli
+)
+
+var allAngles = []Angle{Ascendant, Midheaven, Descendant, ImumCoeli}
+
+// String returns the name of the angle
+func (a Angle) String() string {
+	return angleNames[a]
+}
+
+var angleNames = map[Angle]string{
+	Ascendant:  "Ascendant",
+	Midheaven:  "Midheaven",
+	Descendant: "Descendant",
+	ImumCoeli:  "IC",
+}
+
+// opposite returns the angle across the chart
+func (a Angle) opposite() Angle {
+	return (a + 2) % 4
+}
+
+// Config holds the settings of a rectification
+type Config struct {
+	Step         time.Duration // Interval between candidate times
+	TransitOrb   float64       // Orb of transits to angles and house rulers
+	DirectionOrb float64       // Orb of solar arc directions and progressions
+	Separation   time.Duration // Minimum gap between the returned candidates
+	Limit        int           // Number of candidates returned, 0 for all
+}
+
+// DefaultConfig tests a time every two minutes, during which the
+// Ascendant moves about half a degree
+var DefaultConfig = Config{
+	Step:         2 * time.Minute,
+	TransitOrb:   1.5,
+	DirectionOrb: 1,
+	Separation:   30 * time.Minute,
+	Limit:        5,
+}
+
+// Technique is the predictive method behind a hit
+type Technique string
+
+// Predictive techniques.
+const (
+	Transit     Technique = "transit"
+	SolarArc    Technique = "solar arc"
+	Progression Technique = "progression"
+)
+
+// Hit is a contact found on the date of an event
+type Hit struct {
+	Event       Event     `json:"event"`
+	Technique   Technique `json:"technique"`
+	Description string    `json:"description"`
+	Orb         float64   `json:"orb"`
+	Score       float64   `json:"score"`
+}
+
+// Candidate is a tested birth time with the hits explaining its score
+type Candidate struct {
+	Time      time.Time `json:"time"`
+	Ascendant float64   `json:"ascendant"`
+	Midheaven float64   `json:"midheaven"`
+	Score     float64   `json:"score"`
+	Hits      []Hit     `json:"hits"`
+}
+
+// transitBodies are the transiting bodies, weighed by how strongly they
+// mark the turning points of a life
+var transitBodies = []position.CelestialBody{
+	position.Mars, position.Jupiter, position.Saturn,
+	position.Uranus, position.Neptune, position.Pluto,
+}
+
+var transitWeights = map[position.CelestialBody]float64{
+	position.Mars:    1,
+	position.Jupiter: 1,
+	position.Saturn:  2,
+	position.Uranus:  2,
+	position.Neptune: 1.5,
+	position.Pluto:   2,
+}
+
+// natalBodies are the natal bodies reached by directed angles, and
+// directed to the natal angles
+var natalBodies = []position.CelestialBody{
+	position.Sun, position.Moon, position.Mercury, position.Venus, position.Mars,
+	position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto,
+}
+
+// hardAspects are the aspects of transits to house rulers, with the verb
+// describing them. A slice keeps the hits in the same order on every run.
+var hardAspects = []struct {
+	aspect horoscope.AspectType
+	verb   string
+}{
+	{horoscope.Conjunction, "conjunct"},
+	{horoscope.Square, "square"},
+	{horoscope.Opposition, "opposite"},
+}
+
+// Weights of the techniques, relative to a transit of Mars
+const (
+	directionWeight      = 2.0
+	progressedMoonWeight = 1.0
+	rulerWeight          = 0.75 // Applied on top of the transiting body's weight
+	otherAngleWeight     = 0.5  // Angles that an event kind does not signify
+)
+
+// tropicalYear is the length of a year in days, for progressions
+const tropicalYear = 365.2422
+
+// Rectify scores the birth times from one time to another at the
+// configured step against the events, for a birth at a place. It returns
+// the best candidates, at least Separation apart, best first.
+func Rectify(from, to time.Time, latitude, longitude float64, events []Event, cfg Config) ([]Candidate, error) {
+	switch {
+	case len(events) == 0:
+		return nil, fmt.Errorf("no events")
+	case cfg.Step <= 0:
+		return nil, fmt.Errorf("step must be positive")
+	case to.Before(from):
+		return nil, fmt.Errorf("the window must end after it starts")
+	}
+	for _, e := range events {
+		if !e.Date.After(to) {
+			return nil, fmt.Errorf("event %s is not after the birth", e)
+		}
+	}
+
+	// The transits on the event dates do not depend on the birth time
+	transits := make([]map[position.CelestialBody]position.Position, len(events))
+	for i, e := range events {
+		transits[i] = positionsAt(e.Date, transitBodies)
+	}
+
+	var candidates []Candidate
+	for t := from; !t.After(to); t = t.Add(cfg.Step) {
+		cusps := house.Calculate(latitude, longitude, t)
+		natal := positionsAt(t, natalBodies)
+		c := Candidate{Time: t, Ascendant: cusps.Ascendant, Midheaven: cusps.MC}
+		for i, e := range events {
+			s := scorer{event: e, birth: t, cusps: cusps, natal: natal, transits: transits[i], cfg: cfg}
+			c.Hits = append(c.Hits, s.hits()...)
+		}
+		for _, h := range c.Hits {
+			c.Score += h.Score
+		}
+		candidates = append(candidates, c)
+	}
+	return best(candidates, cfg), nil
+}
+
+func positionsAt(t time.Time, bodies []position.CelestialBody) map[position.CelestialBody]position.Position {
+	positions := make(map[position.CelestialBody]position.Position, len(bodies))
+	for _, body := range bodies {
+		positions[body] = position.Calculate(body, t)
+	}
+	return positions
+}
+
+// best keeps the highest scores at least cfg.Separation apart, so that
+// neighbouring times of the same peak are not all returned
+func best(candidates []Candidate, cfg Config) []Candidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	var kept []Candidate
+	for _, c := range candidates {
+		if cfg.Limit > 0 && len(kept) == cfg.Limit {
+			break
+		}
+		near := slices.ContainsFunc(kept, func(k Candidate) bool {
+			return math.Abs(c.Time.Sub(k.Time).Minutes()) < cfg.Separation.Minutes()
+		})
+		if !near {
+			sort.SliceStable(c.Hits, func(i, j int) bool {
+				return c.Hits[i].Score > c.Hits[j].Score
+			})
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// scorer finds the hits of one event for one candidate time
+type scorer struct {
+	event    Event
+	birth    time.Time
+	cusps    *house.Cusps
+	natal    map[position.CelestialBody]position.Position
+	transits map[position.CelestialBody]position.Position
+	cfg      Config
+}
+
+func (s scorer) hits() []Hit {
+	var hits []Hit
+	hits = append(hits, s.transitsToAngles()...)
+	hits = append(hits, s.solarArcs()...)
+	hits = append(hits, s.progressedMoon()...)
+	hits = append(hits, s.transitsToRulers()...)
+	return hits
+}
+
+func (s scorer) angle(a Angle) float64 {
+	switch a {
+	case Midheaven:
+		return s.cusps.MC
+	case Descendant:
+		return s.cusps.Descendant
+	case ImumCoeli:
+		return s.cusps.IC
+	default:
+		return s.cusps.Ascendant
+	}
+}
+
+// relevance weighs an angle by whether the event's kind signifies it
+func (s scorer) relevance(a Angle) float64 {
+	if slices.Contains(kindTargets[s.event.Kind].angles, a) {
+		return 1
+	}
+	return otherAngleWeight
+}
+
+func (s scorer) hit(technique Technique, weight, orb, maxOrb float64, format string, args ...any) Hit {
+	return Hit{
+		Event:       s.event,
+		Technique:   technique,
+		Description: fmt.Sprintf(format, args...),
+		Orb:         orb,
+		Score:       weight * (1 - orb/maxOrb),
+	}
+}
+
+// transitsToAngles finds the slow transits conjunct or square the angles.
+// A conjunction to an angle is an opposition to the one across, and a
+// square falls on both ends of an axis, so squares are counted once per
+// axis, on the end the event signifies most.
+func (s scorer) transitsToAngles() []Hit {
+	var hits []Hit
+	for _, body := range transitBodies {
+		lon := s.transits[body].EclipticLongitude
+		for _, a := range allAngles {
+			if orb := aspectOrb(lon, s.angle(a), horoscope.Conjunction); orb <= s.cfg.TransitOrb {
+				hits = append(hits, s.hit(Transit, transitWeights[body]*s.relevance(a), orb, s.cfg.TransitOrb,
+					"transiting %s conjunct %s", body, a))
+			}
+		}
+		for _, a := range []Angle{Ascendant, Midheaven} {
+			if orb := aspectOrb(lon, s.angle(a), horoscope.Square); orb <= s.cfg.TransitOrb {
+				end := a
+				if s.relevance(a.opposite()) > s.relevance(a) {
+					end = a.opposite()
+				}
+				hits = append(hits, s.hit(Transit, transitWeights[body]*s.relevance(end), orb, s.cfg.TransitOrb,
+					"transiting %s square %s", body, end))
+			}
+		}
+	}
+	return hits
+}
+
+// solarArcs directs the angles and the natal bodies by the arc of the
+// progressed Sun and finds the conjunctions they form with natal bodies
+// and angles
+func (s scorer) solarArcs() []Hit {
+	progressed := s.progressedTime()
+	arc := position.NormalizeAngle(position.Calculate(position.Sun, progressed).EclipticLongitude - s.natal[position.Sun].EclipticLongitude)
+
+	var hits []Hit
+	for _, a := range allAngles {
+		directed := s.angle(a) + arc
+		for _, body := range natalBodies {
+			if orb := aspectOrb(directed, s.natal[body].EclipticLongitude, horoscope.Conjunction); orb <= s.cfg.DirectionOrb {
+				hits = append(hits, s.hit(SolarArc, directionWeight*s.relevance(a), orb, s.cfg.DirectionOrb,
+					"solar arc %s conjunct natal %s", a, body))
+			}
+		}
+	}
+	for _, body := range natalBodies {
+		directed := s.natal[body].EclipticLongitude + arc
+		for _, a := range allAngles {
+			if orb := aspectOrb(directed, s.angle(a), horoscope.Conjunction); orb <= s.cfg.DirectionOrb {
+				hits = append(hits, s.hit(SolarArc, directionWeight*s.relevance(a), orb, s.cfg.DirectionOrb,
+					"solar arc %s conjunct %s", body, a))
+			}
+		}
+	}
+	return hits
+}
+
+// progressedMoon finds the secondary progressed Moon conjunct an angle
+func (s scorer) progressedMoon() []Hit {
+	moon := position.Calculate(position.Moon, s.progressedTime()).EclipticLongitude
+	var hits []Hit
+	for _, a := range allAngles {
+		if orb := aspectOrb(moon, s.angle(a), horoscope.Conjunction); orb <= s.cfg.DirectionOrb {
+			hits = append(hits, s.hit(Progression, progressedMoonWeight*s.relevance(a), orb, s.cfg.DirectionOrb,
+				"progressed Moon conjunct %s", a))
+		}
+	}
+	return hits
+}
+
+// transitsToRulers finds the slow transits in hard aspect to the natal
+// rulers of the houses the event's kind signifies
+func (s scorer) transitsToRulers() []Hit {
+	var hits []Hit
+	for _, h := range kindTargets[s.event.Kind].houses {
+		ruler := s.cusps.Houses[h-1].Sign.Ruler()
+		natal, ok := s.natal[ruler]
+		if !ok {
+			continue
+		}
+		for _, body := range transitBodies {
+			if body == ruler {
+				continue
+			}
+			for _, hard := range hardAspects {
+				orb := aspectOrb(s.transits[body].EclipticLongitude, natal.EclipticLongitude, hard.aspect)
+				if orb <= s.cfg.TransitOrb {
+					hits = append(hits, s.hit(Transit, rulerWeight*transitWeights[body], orb, s.cfg.TransitOrb,
+						"transiting %s %s natal %s, ruler of the %s house", body, hard.verb, ruler, ordinal(h)))
+				}
+			}
+		}
+	}
+	return hits
+}
+
+// progressedTime returns the secondary progressed date of the event: one
+// day after the birth for each year of life
+func (s scorer) progressedTime() time.Time {
+	years := s.event.Date.Sub(s.birth).Hours() / 24 / tropicalYear
+	return s.birth.Add(time.Duration(years * 24 * float64(time.Hour)))
+}
+
+// aspectOrb returns the distance in degrees between the separation of two
+// longitudes and an aspect
+func aspectOrb(a, b float64, aspect horoscope.AspectType) float64 {
+	sep := math.Abs(position.NormalizeAngle(a-b+180) - 180)
+	return math.Abs(sep - aspect.Angle())
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	}
+	return fmt.Sprintf("%dth", n)
+}
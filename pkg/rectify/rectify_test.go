@@ -0,0 +1,62 @@
+package rectify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/internal/house"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Synthetic subject born in Paris at 15:37 UTC
+var testBirth = time.Date(1985, 6, 14, 15, 37, 0, 0, time.UTC)
+
+const testLat, testLon = 48.85, 2.35
+
+// syntheticEvents dates nine events on the first days a slow planet is
+// conjunct or square the subject's Ascendant or Midheaven
+func syntheticEvents(t *testing.T) []Event {
+	t.Helper()
+	cusps := house.Calculate(testLat, testLon, testBirth)
+	angles := []struct {
+		lon  float64
+		kind Kind
+	}{{cusps.Ascendant, Health}, {cusps.MC, Career}}
+
+	var events []Event
+	for _, body := range []position.CelestialBody{position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto} {
+		for _, a := range angles {
+			for _, aspect := range []horoscope.AspectType{horoscope.Conjunction, horoscope.Square} {
+				for day := testBirth.AddDate(1, 0, 0); day.Before(testBirth.AddDate(60, 0, 0)); day = day.AddDate(0, 0, 1) {
+					if aspectOrb(position.Calculate(body, day).EclipticLongitude, a.lon, aspect) < 0.2 {
+						events = append(events, Event{Date: day, Kind: a.kind})
+						break
+					}
+				}
+				if len(events) == 9 {
+					return events
+				}
+			}
+		}
+	}
+	t.Fatalf("found %d events, want 9", len(events))
+	return nil
+}
+
+func TestRectifySyntheticSubject(t *testing.T) {
+	events := syntheticEvents(t)
+	from := time.Date(1985, 6, 14, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24*time.Hour - time.Minute)
+
+	candidates, err := Rectify(from, to, testLat, testLon, events, DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) == 0 {
+		t.Fatal("no candidate")
+	}
+	if best := candidates[0].Time; best.Sub(testBirth).Abs() > 4*time.Minute {
+		t.Errorf("best candidate %s, want within 4 minutes of 15:37", best.Format("15:04"))
+	}
+}
@@ -0,0 +1,76 @@
+package stats
+
+import "math"
+
+// ChiSquare returns the chi-square statistic of observed counts against
+// expected counts, with its degrees of freedom. Categories expected to be
+// empty are left out.
+func ChiSquare(observed []int, expected []float64) (float64, int) {
+	chi2 := 0.0
+	categories := 0
+	for i, e := range expected {
+		if e <= 0 {
+			continue
+		}
+		d := float64(observed[i]) - e
+		chi2 += d * d / e
+		categories++
+	}
+	return chi2, max(categories-1, 0)
+}
+
+// ChiSquarePValue returns the probability of a chi-square value at least
+// as large as chi2 under the null hypothesis
+func ChiSquarePValue(chi2 float64, df int) float64 {
+	if df <= 0 {
+		return 1
+	}
+	return upperGamma(float64(df)/2, chi2/2)
+}
+
+// upperGamma is the regularized upper incomplete gamma function Q(a, x),
+// by series below a+1 and continued fraction above (Numerical Recipes 6.2)
+func upperGamma(a, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	lgamma, _ := math.Lgamma(a)
+	prefix := math.Exp(-x + a*math.Log(x) - lgamma)
+
+	if x < a+1 {
+		sum, term := 1/a, 1/a
+		for n := 1.0; n < 500; n++ {
+			term *= x / (a + n)
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return 1 - sum*prefix
+	}
+
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1.0; i < 500; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return h * prefix
+}
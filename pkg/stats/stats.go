@@ -0,0 +1,303 @@
+// Package stats tallies astrological factors over a set of charts and
+// compares them with a control group by chi-square tests.
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ctrl-vfr/astral-tui/pkg/batch"
+	"github.com/ctrl-vfr/astral-tui/pkg/horoscope"
+	"github.com/ctrl-vfr/astral-tui/pkg/position"
+)
+
+// Factor is what a distribution counts
+type Factor string
+
+// Counted factors.
+const (
+	FactorSign     Factor = "sign"
+	FactorHouse    Factor = "house"
+	FactorElement  Factor = "element"
+	FactorModality Factor = "modality"
+	FactorSector   Factor = "sector" // Gauquelin sector
+	FactorAspect   Factor = "aspect"
+)
+
+// noAspect labels the pairs of bodies without an aspect
+const noAspect = "None"
+
+// Distribution is the frequency of one factor, for one body or body pair,
+// in the sample and as expected from the control group
+type Distribution struct {
+	Factor    Factor    `json:"factor"`
+	Subject   string    `json:"subject"` // Body, body pair, or "All" for aspect types
+	Labels    []string  `json:"labels"`
+	Observed  []int     `json:"observed"`
+	Expected  []float64 `json:"expected"`
+	ChiSquare float64   `json:"chi_square"`
+	DF        int       `json:"df"`
+	PValue    float64   `json:"p_value"`
+}
+
+// Report holds every distribution of a sample
+type Report struct {
+	Sample        int            `json:"sample"`
+	Control       int            `json:"control"`
+	Distributions []Distribution `json:"distributions"`
+}
+
+// Bodies returns the bodies whose positions are tallied
+func Bodies() []position.CelestialBody {
+	return []position.CelestialBody{
+		position.Sun, position.Moon, position.Mercury, position.Venus, position.Mars,
+		position.Jupiter, position.Saturn, position.Uranus, position.Neptune, position.Pluto,
+	}
+}
+
+// Analyze tallies the sample charts and compares them with the control
+// charts. Without a control group every category is equally expected,
+// which suits houses and sectors but not signs.
+func Analyze(sample, control []*horoscope.Chart) Report {
+	expected := make(map[string]Distribution)
+	for _, d := range tally(control) {
+		expected[key(d)] = d
+	}
+
+	report := Report{Sample: len(sample), Control: len(control)}
+	for _, d := range tally(sample) {
+		d.Expected = make([]float64, len(d.Labels))
+		if len(control) > 0 {
+			ctl := expected[key(d)]
+			scale := float64(total(d.Observed)) / float64(max(total(ctl.Observed), 1))
+			for i, n := range ctl.Observed {
+				d.Expected[i] = float64(n) * scale
+			}
+		} else {
+			for i := range d.Expected {
+				d.Expected[i] = float64(total(d.Observed)) / float64(len(d.Labels))
+			}
+		}
+		d.ChiSquare, d.DF = ChiSquare(d.Observed, d.Expected)
+		d.PValue = ChiSquarePValue(d.ChiSquare, d.DF)
+		report.Distributions = append(report.Distributions, d)
+	}
+	return report
+}
+
+// tally counts every factor over a set of charts, in report order
+func tally(charts []*horoscope.Chart) []Distribution {
+	order := newDistributions()
+	dists := make(map[string]Distribution, len(order))
+	for _, d := range order {
+		dists[key(d)] = d
+	}
+
+	count := func(factor Factor, subject string, index int) {
+		if index < 0 {
+			return
+		}
+		dists[string(factor)+"|"+subject].Observed[index]++
+	}
+
+	for _, c := range charts {
+		for _, body := range Bodies() {
+			pos := c.GetPosition(body)
+			if pos == nil {
+				continue
+			}
+			name := body.String()
+			sign := horoscope.LongitudeToZodiac(pos.EclipticLongitude).Sign
+			count(FactorSign, name, int(sign))
+			count(FactorElement, name, int(sign.Element()))
+			count(FactorModality, name, int(sign.Modality()))
+			if c.Houses != nil {
+				count(FactorHouse, name, c.BodyInHouse(body)-1)
+			}
+			if sector, ok := c.GauquelinSector(body); ok {
+				count(FactorSector, name, sector-1)
+			}
+		}
+
+		aspects := make(map[string]horoscope.AspectType)
+		for _, a := range c.Aspects {
+			if !isTallied(a.Body1) || !isTallied(a.Body2) || a.Type.IsDeclination() {
+				continue
+			}
+			count(FactorAspect, "All", int(a.Type))
+			aspects[pairName(a.Body1, a.Body2)] = a.Type
+		}
+		for _, pair := range pairs() {
+			name := pairName(pair[0], pair[1])
+			if t, ok := aspects[name]; ok {
+				count(FactorAspect, name, int(t))
+			} else {
+				count(FactorAspect, name, len(longitudeAspects()))
+			}
+		}
+	}
+
+	return order
+}
+
+// newDistributions lists the empty distributions in report order
+func newDistributions() []Distribution {
+	var signs, elements, modalities, houses, sectors []string
+	for _, s := range horoscope.AllSigns() {
+		signs = append(signs, s.String())
+	}
+	for e := horoscope.Fire; e <= horoscope.Water; e++ {
+		elements = append(elements, e.String())
+	}
+	for m := horoscope.Cardinal; m <= horoscope.Mutable; m++ {
+		modalities = append(modalities, m.String())
+	}
+	for i := 1; i <= 12; i++ {
+		houses = append(houses, strconv.Itoa(i))
+	}
+	for i := 1; i <= horoscope.GauquelinSectorCount; i++ {
+		sectors = append(sectors, strconv.Itoa(i))
+	}
+
+	var dists []Distribution
+	add := func(factor Factor, subject string, labels []string) {
+		dists = append(dists, Distribution{
+			Factor: factor, Subject: subject, Labels: labels, Observed: make([]int, len(labels)),
+		})
+	}
+	for _, body := range Bodies() {
+		add(FactorSign, body.String(), signs)
+		add(FactorElement, body.String(), elements)
+		add(FactorModality, body.String(), modalities)
+		add(FactorHouse, body.String(), houses)
+		add(FactorSector, body.String(), sectors)
+	}
+
+	var types []string
+	for _, t := range longitudeAspects() {
+		types = append(types, t.String())
+	}
+	add(FactorAspect, "All", types)
+	for _, pair := range pairs() {
+		add(FactorAspect, pairName(pair[0], pair[1]), append(append([]string(nil), types...), noAspect))
+	}
+	return dists
+}
+
+// Filter returns the distributions of one factor
+func (r Report) Filter(factor Factor) []Distribution {
+	var result []Distribution
+	for _, d := range r.Distributions {
+		if d.Factor == factor {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// longitudeAspects returns the aspect types counted, in AspectType order
+func longitudeAspects() []horoscope.AspectType {
+	var types []horoscope.AspectType
+	for _, t := range horoscope.AllAspectTypes() {
+		if !t.IsDeclination() {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// pairs returns every pair of tallied bodies
+func pairs() [][2]position.CelestialBody {
+	bodies := Bodies()
+	var result [][2]position.CelestialBody
+	for i := range bodies {
+		for j := i + 1; j < len(bodies); j++ {
+			result = append(result, [2]position.CelestialBody{bodies[i], bodies[j]})
+		}
+	}
+	return result
+}
+
+func pairName(a, b position.CelestialBody) string {
+	if a > b {
+		a, b = b, a
+	}
+	return a.String() + "-" + b.String()
+}
+
+func isTallied(body position.CelestialBody) bool {
+	return body <= position.Pluto
+}
+
+func key(d Distribution) string {
+	return string(d.Factor) + "|" + d.Subject
+}
+
+func total(counts []int) int {
+	n := 0
+	for _, c := range counts {
+		n += c
+	}
+	return n
+}
+
+// Shuffle builds a control group of n records by drawing the date, the
+// time of day and the place of each record independently from the sample.
+// This keeps the seasonal, daily and geographic spread of the data while
+// breaking any link between them.
+func Shuffle(records []batch.Record, n int, rng *rand.Rand) []batch.Record {
+	var valid []batch.Record
+	for _, r := range records {
+		if r.Err == nil && !r.DateTime.IsZero() {
+			valid = append(valid, r)
+		}
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+
+	control := make([]batch.Record, n)
+	for i := range control {
+		date := valid[rng.IntN(len(valid))].DateTime
+		clock := valid[rng.IntN(len(valid))].DateTime
+		place := valid[rng.IntN(len(valid))]
+
+		// Clock times are local, so they take the time zone of the place
+		control[i] = batch.Record{
+			ID: fmt.Sprintf("control-%d", i+1),
+			DateTime: time.Date(date.Year(), date.Month(), date.Day(),
+				clock.Hour(), clock.Minute(), clock.Second(), 0, place.DateTime.Location()),
+			Latitude:  place.Latitude,
+			Longitude: place.Longitude,
+			Location:  place.Location,
+		}
+	}
+	return control
+}
+
+// WriteTable writes each distribution as a text table with its chi-square
+// test. Deviations are observed minus expected counts.
+func (r Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "Sample: %d charts, control: %d charts\n", r.Sample, r.Control)
+	for _, d := range r.Distributions {
+		fmt.Fprintf(tw, "\n%s by %s (χ² = %.2f, df = %d, p = %.4f)\n", d.Subject, d.Factor, d.ChiSquare, d.DF, d.PValue)
+		fmt.Fprintf(tw, "\tobserved\texpected\tdeviation\t\n")
+		for i, label := range d.Labels {
+			fmt.Fprintf(tw, "%s\t%d\t%.1f\t%+.1f\t\n", label, d.Observed[i], d.Expected[i], float64(d.Observed[i])-d.Expected[i])
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteJSON writes the report as indented JSON
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}